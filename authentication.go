@@ -2,6 +2,7 @@ package rememberthemilk
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -55,6 +56,12 @@ type GetTokenResponse struct {
 	BaseResponse
 }
 
+type CheckTokenResponse struct {
+	Authentication Authentication `json:"auth"`
+
+	BaseResponse
+}
+
 type Authentication struct {
 	Permissions string `json:"perms,omitempty"`
 	Token       string `json:"token,omitempty"`
@@ -139,6 +146,8 @@ func (s *AuthenticationService) GetFrob(ctx context.Context) (string, *Response,
 }
 
 // GetToken returns the auth token for the given frob, if one has been attached.
+// If the client uses a TokenStore, the token is set on the client and saved to the store.
+// See Client.UseTokenStore.
 //
 // Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.auth.getToken.rtm
 func (s *AuthenticationService) GetToken(ctx context.Context, frob string) (*Authentication, *Response, error) {
@@ -166,5 +175,93 @@ func (s *AuthenticationService) GetToken(ctx context.Context, frob string) (*Aut
 		return nil, resp, err
 	}
 
+	auth := &apiResponse.Response.Authentication
+	if store := s.client.getTokenStore(); store != nil {
+		s.client.SetAuthentication(auth)
+		if err := store.Save(ctx, auth); err != nil {
+			return auth, resp, fmt.Errorf("saving token: %w", err)
+		}
+	}
+
+	return auth, resp, nil
+}
+
+// CheckToken returns the credentials attached to the authentication token of the client.
+// The client remembers the permission level of the token, see Client.Permissions.
+// If the API rejects the token, it is removed from the client and, if the client uses
+// a TokenStore which holds the same token, deleted from the store.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.auth.checkToken.rtm
+func (s *AuthenticationService) CheckToken(ctx context.Context) (*Authentication, *Response, error) {
+	token := s.client.authentication()
+	auth, resp, err := s.checkToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			if forgetErr := s.forgetToken(ctx, token); forgetErr != nil {
+				return nil, resp, errors.Join(err, forgetErr)
+			}
+		}
+		return nil, resp, err
+	}
+
+	s.client.authMu.Lock()
+	if auth.Token == s.client.authenticationToken {
+		s.client.permissions = auth.Permissions
+	}
+	s.client.authMu.Unlock()
+
+	return auth, resp, nil
+}
+
+// checkToken calls rtm.auth.checkToken for token without modifying the client or its TokenStore.
+func (s *AuthenticationService) checkToken(ctx context.Context, token string) (*Authentication, *Response, error) {
+	opts := s.client.addBaseAPIURLOptions("rtm.auth.checkToken")
+	opts.AuthenticationToken = token
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response struct {
+			CheckTokenResponse
+		} `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
 	return &apiResponse.Response.Authentication, resp, nil
 }
+
+// forgetToken removes the rejected token from the client and from its TokenStore.
+// A different token, e.g. one set in the meantime, is kept.
+func (s *AuthenticationService) forgetToken(ctx context.Context, token string) error {
+	s.client.authMu.Lock()
+	if s.client.authenticationToken == token {
+		s.client.authenticationToken = ""
+		s.client.permissions = ""
+	}
+	s.client.authMu.Unlock()
+
+	store := s.client.getTokenStore()
+	if store == nil {
+		return nil
+	}
+	stored, err := store.Load(ctx)
+	switch {
+	case errors.Is(err, ErrTokenNotFound):
+		return nil
+	case err != nil:
+		return err
+	case stored.Token != token:
+		return nil
+	}
+	return store.Delete(ctx)
+}
@@ -0,0 +1,223 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+const (
+	defaultPollInterval    = 2 * time.Second
+	defaultMaxPollInterval = 30 * time.Second
+)
+
+// BrowserOpener opens url in the web browser of the user.
+type BrowserOpener func(url string) error
+
+// OpenBrowser opens url in the default web browser of the operating system.
+func OpenBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+// Authenticator runs the interactive authentication flow for desktop and command line applications.
+//
+// By default, it uses the desktop flow: it requests a frob, opens the authentication URL
+// and polls AuthenticationService.GetToken until the user approved the application.
+//
+// If CallbackAddr is set, it uses the web-based flow instead: it starts a loopback HTTP server
+// on CallbackAddr, opens the authentication URL without a frob and waits for Remember The Milk
+// to redirect the browser to the callback URL with the frob. The callback URL of the API key
+// has to point to this server, e.g. http://127.0.0.1:8765/.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/authentication.rtm
+type Authenticator struct {
+	// Client is used to talk to the API. On success, the authentication is set on it.
+	Client *Client
+
+	// Permission is the requested permission level. See constants Permission*.
+	Permission string
+
+	// Opener opens the authentication URL. If nil, OpenBrowser is used.
+	Opener BrowserOpener
+
+	// CallbackAddr is the loopback address the callback server listens on, e.g. "127.0.0.1:8765".
+	// If empty, the desktop flow is used.
+	CallbackAddr string
+
+	// PollInterval is the initial wait time between two AuthenticationService.GetToken calls.
+	// It doubles after every call up to MaxPollInterval. Defaults to 2 seconds.
+	PollInterval time.Duration
+
+	// MaxPollInterval is the maximum wait time between two AuthenticationService.GetToken calls.
+	// Defaults to 30 seconds.
+	MaxPollInterval time.Duration
+}
+
+// Authenticate runs the authentication flow until the user approved the application
+// or ctx is done. Set a deadline on ctx to limit the time the user has for approval.
+func (a *Authenticator) Authenticate(ctx context.Context) (*Authentication, error) {
+	if a.Client == nil {
+		return nil, errors.New("authenticator has no client")
+	}
+
+	var frob string
+	var err error
+	if a.CallbackAddr == "" {
+		frob, err = a.desktopFlow(ctx)
+	} else {
+		frob, err = a.webFlow(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := a.pollToken(ctx, frob)
+	if err != nil {
+		return nil, err
+	}
+	a.Client.SetAuthentication(auth)
+	return auth, nil
+}
+
+// desktopFlow requests a frob and opens the authentication URL for it.
+func (a *Authenticator) desktopFlow(ctx context.Context) (string, error) {
+	frob, _, err := a.Client.Authentication.GetFrob(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := a.Client.Authentication.GetAuthenticationURLWithFrob(a.Permission, frob)
+	if err != nil {
+		return "", err
+	}
+	if err := a.open(u); err != nil {
+		return "", err
+	}
+	return frob, nil
+}
+
+// webFlow opens the authentication URL and waits for the frob on the loopback callback server.
+func (a *Authenticator) webFlow(ctx context.Context) (string, error) {
+	if err := checkLoopback(a.CallbackAddr); err != nil {
+		return "", err
+	}
+
+	listener, err := net.Listen("tcp", a.CallbackAddr)
+	if err != nil {
+		return "", err
+	}
+
+	frobs := make(chan string, 1)
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			frob := r.URL.Query().Get("frob")
+			if frob == "" {
+				http.Error(w, "missing frob", http.StatusBadRequest)
+				return
+			}
+			// Respond before handing over the frob, as the server is shut down once it is received.
+			fmt.Fprintln(w, "Authentication complete. You can close this window.")
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			select {
+			case frobs <- frob:
+			default:
+			}
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go server.Serve(listener)
+	defer func() {
+		// ctx may already be done, so the shutdown gets its own deadline.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	u, err := a.Client.Authentication.GetAuthenticationURL(a.Permission)
+	if err != nil {
+		return "", err
+	}
+	if err := a.open(u); err != nil {
+		return "", err
+	}
+
+	select {
+	case frob := <-frobs:
+		return frob, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// pollToken calls AuthenticationService.GetToken with an increasing interval
+// until the user approved the application. The API reports a frob which has
+// not been approved yet as ErrInvalidFrob.
+func (a *Authenticator) pollToken(ctx context.Context, frob string) (*Authentication, error) {
+	interval := a.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	maxInterval := a.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxPollInterval
+	}
+
+	for {
+		auth, _, err := a.Client.Authentication.GetToken(ctx, frob)
+		if err == nil {
+			return auth, nil
+		}
+
+		if !errors.Is(err, ErrInvalidFrob) {
+			return nil, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxInterval)
+	}
+}
+
+func (a *Authenticator) open(url string) error {
+	if a.Opener == nil {
+		return OpenBrowser(url)
+	}
+	return a.Opener(url)
+}
+
+// checkLoopback returns an error if addr does not refer to the loopback interface.
+// The callback server must not be reachable from other hosts.
+func checkLoopback(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("callback address %q is not a loopback address", addr)
+}
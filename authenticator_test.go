@@ -0,0 +1,156 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAuthenticator_desktopFlow(t *testing.T) {
+	client, mux := setup(t)
+	client.SetAuthenticationToken("")
+
+	var polls atomic.Int32
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("method") {
+		case "rtm.auth.getFrob":
+			fmt.Fprint(w, `{"rsp":{"stat":"ok","frob":"frob"}}`)
+		case "rtm.auth.getToken":
+			testParam(t, r, "frob", "frob")
+			if polls.Add(1) < 3 {
+				fmt.Fprint(w, `{"rsp":{"stat":"fail","err":{"code":"101","msg":"Invalid frob - did you authenticate?"}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"rsp":{"stat":"ok","auth":{"token":"token","perms":"write","user":{"id":"1","username":"bob"}}}}`)
+		}
+	})
+
+	var opened string
+	authenticator := &Authenticator{
+		Client:       client,
+		Permission:   PermissionWrite,
+		Opener:       func(u string) error { opened = u; return nil },
+		PollInterval: time.Millisecond,
+	}
+	auth, err := authenticator.Authenticate(context.Background())
+	if err != nil {
+		t.Fatalf("Authenticate returned error: %v", err)
+	}
+
+	if auth.Token != "token" || client.authentication() != "token" || client.Permissions() != PermissionWrite {
+		t.Errorf("Authenticate returned %+v and set token %q with %q permission, want token %q with %q permission", auth, client.authentication(), client.Permissions(), "token", PermissionWrite)
+	}
+	if polls.Load() != 3 {
+		t.Errorf("Authenticate polled %d times, want 3", polls.Load())
+	}
+	u, err := url.Parse(opened)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Query().Get("frob") != "frob" || u.Query().Get("perms") != PermissionWrite {
+		t.Errorf("Authenticate opened %q, want an authentication URL with frob and write permission", opened)
+	}
+}
+
+func TestAuthenticator_webFlow(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.auth.getToken")
+		testParam(t, r, "frob", "callback-frob")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","auth":{"token":"token","perms":"read","user":{"id":"1","username":"bob"}}}}`)
+	})
+
+	addr := freeLoopbackAddr(t)
+	pages := make(chan string, 1)
+	authenticator := &Authenticator{
+		Client:       client,
+		Permission:   PermissionRead,
+		CallbackAddr: addr,
+		// Remember The Milk redirects the browser to the callback URL after the user approved.
+		Opener: func(string) error {
+			go func() {
+				resp, err := http.Get("http://" + addr + "/?frob=callback-frob")
+				if err != nil {
+					pages <- err.Error()
+					return
+				}
+				defer resp.Body.Close()
+				page, err := io.ReadAll(resp.Body)
+				if err != nil {
+					pages <- err.Error()
+					return
+				}
+				pages <- string(page)
+			}()
+			return nil
+		},
+	}
+	auth, err := authenticator.Authenticate(context.Background())
+	if err != nil {
+		t.Fatalf("Authenticate returned error: %v", err)
+	}
+	if auth.Token != "token" {
+		t.Errorf("Authenticate returned token %q, want %q", auth.Token, "token")
+	}
+	if page := <-pages; !strings.Contains(page, "Authentication complete") {
+		t.Errorf("Callback server responded with %q, want the success page", page)
+	}
+}
+
+func TestAuthenticator_deadline(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("method") {
+		case "rtm.auth.getFrob":
+			fmt.Fprint(w, `{"rsp":{"stat":"ok","frob":"frob"}}`)
+		case "rtm.auth.getToken":
+			fmt.Fprint(w, `{"rsp":{"stat":"fail","err":{"code":"101","msg":"Invalid frob - did you authenticate?"}}}`)
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	authenticator := &Authenticator{
+		Client:       client,
+		Opener:       func(string) error { return nil },
+		PollInterval: time.Millisecond,
+	}
+	if _, err := authenticator.Authenticate(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Authenticate returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestAuthenticator_nonLoopbackCallback(t *testing.T) {
+	client, _ := setup(t)
+
+	authenticator := &Authenticator{
+		Client:       client,
+		Opener:       func(string) error { return nil },
+		CallbackAddr: "0.0.0.0:8765",
+	}
+	if _, err := authenticator.Authenticate(context.Background()); err == nil {
+		t.Error("Authenticate returned no error for a non-loopback callback address")
+	}
+}
+
+// freeLoopbackAddr returns a loopback address with a currently unused port.
+func freeLoopbackAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
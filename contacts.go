@@ -26,6 +26,29 @@ type Contact struct {
 	Username string `json:"username"`
 }
 
+type ContactAddInput struct {
+	Timeline string `url:"timeline,omitempty"`
+
+	// Contact is the username or email address of a Remember The Milk user.
+	Contact string `url:"contact"`
+
+	BaseAPIURLOptions
+}
+
+type ContactDeleteInput struct {
+	Timeline  string `url:"timeline,omitempty"`
+	ContactID string `url:"contact_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+type ContactModifyResponse struct {
+	Transaction Transaction `json:"transaction"`
+	Contact     Contact     `json:"contact"`
+
+	BaseResponse
+}
+
 // GetList retrieves a list of contacts.
 //
 // Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.contacts.getList.rtm
@@ -53,3 +76,23 @@ func (s *ContactsService) GetList(ctx context.Context) ([]Contact, *Response, er
 
 	return apiResponse.Response.ContactsGetListResponse.Contacts.Contact, resp, nil
 }
+
+// Add adds a new contact.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.contacts.add.rtm
+func (s *ContactsService) Add(ctx context.Context, contact ContactAddInput) (*ContactModifyResponse, *Response, error) {
+	contact.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.contacts.add")
+	return doAPI[ContactModifyResponse](ctx, s.client, contact)
+}
+
+// Delete deletes a contact. The returned ContactModifyResponse only carries the transaction.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.contacts.delete.rtm
+func (s *ContactsService) Delete(ctx context.Context, contact ContactDeleteInput) (*ContactModifyResponse, *Response, error) {
+	contact.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.contacts.delete")
+	return doAPI[ContactModifyResponse](ctx, s.client, contact)
+}
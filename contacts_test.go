@@ -0,0 +1,61 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestContactsService_Add(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.contacts.add")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "contact", "bob")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"0"},
+			"contact":{"id":"1","fullname":"Bob T. Monkey","username":"bob"}}}`)
+	})
+
+	input := ContactAddInput{
+		Timeline: "12741021",
+		Contact:  "bob",
+	}
+	result, _, err := client.Contacts.Add(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Contacts.Add returned error: %v", err)
+	}
+
+	want := Contact{ID: "1", FullName: "Bob T. Monkey", Username: "bob"}
+	if result.Contact != want {
+		t.Errorf("Contacts.Add returned %+v, want %+v", result.Contact, want)
+	}
+	if result.Transaction.ID != "4711" {
+		t.Errorf("Contacts.Add returned transaction %q, want %q", result.Transaction.ID, "4711")
+	}
+}
+
+func TestContactsService_Delete(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.contacts.delete")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "contact_id", "1")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4712","undoable":"0"}}}`)
+	})
+
+	input := ContactDeleteInput{
+		Timeline:  "12741021",
+		ContactID: "1",
+	}
+	result, _, err := client.Contacts.Delete(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Contacts.Delete returned error: %v", err)
+	}
+
+	if result.Transaction.ID != "4712" {
+		t.Errorf("Contacts.Delete returned transaction %q, want %q", result.Transaction.ID, "4712")
+	}
+}
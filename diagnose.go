@@ -0,0 +1,158 @@
+package rememberthemilk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DiagnosticProblem classifies why the Client cannot talk to the Remember The Milk API.
+type DiagnosticProblem string
+
+const (
+	// ProblemNone means all checks passed.
+	ProblemNone DiagnosticProblem = ""
+	// ProblemNetwork means the API could not be reached.
+	ProblemNetwork DiagnosticProblem = "network"
+	// ProblemService means the API was reachable but unavailable or rate limited.
+	ProblemService DiagnosticProblem = "service"
+	// ProblemAPIKey means the API key was rejected.
+	ProblemAPIKey DiagnosticProblem = "api_key"
+	// ProblemSharedSecret means the request signature was rejected, most likely due to a wrong shared secret.
+	ProblemSharedSecret DiagnosticProblem = "shared_secret"
+	// ProblemToken means the authentication token is missing or was rejected.
+	ProblemToken DiagnosticProblem = "token"
+	// ProblemUnknown means a check failed for a reason not covered above. See DiagnosticReport.Err.
+	ProblemUnknown DiagnosticProblem = "unknown"
+)
+
+// DiagnosticReport is the result of Client.Diagnose.
+type DiagnosticReport struct {
+	// Reachable reports whether the API answered at all.
+	Reachable bool
+
+	// Latency is the round trip time of the rtm.test.echo call.
+	Latency time.Duration
+
+	// ClockSkew is the difference between the clock of the API server and the local clock,
+	// as far as the second precision of the HTTP Date header allows.
+	// A positive value means the local clock is behind.
+	ClockSkew time.Duration
+
+	// SignatureValid reports whether the API accepted the API key and the request signature.
+	SignatureValid bool
+
+	// TokenValid reports whether the API accepted the authentication token.
+	TokenValid bool
+
+	// Permissions is the permission level of the authentication token. See constants Permission*.
+	Permissions string
+
+	// Username is the user the authentication token belongs to.
+	Username string
+
+	// Problem classifies the first failing check.
+	Problem DiagnosticProblem
+
+	// Err is the error of the first failing check.
+	Err error
+}
+
+// Diagnose checks the connectivity to the Remember The Milk API and the credentials of the client.
+//
+// It calls rtm.test.echo to check reachability, clock skew, API key and shared secret,
+// followed by rtm.auth.checkToken to check the authentication token and its permission level.
+// Checks stop at the first failure, which is classified in DiagnosticReport.Problem.
+//
+// Diagnose does not modify the client: unlike AuthenticationService.CheckToken, it neither
+// remembers the permission level nor removes a rejected token from the client or its TokenStore.
+func (c *Client) Diagnose(ctx context.Context) *DiagnosticReport {
+	report := &DiagnosticReport{}
+
+	nonce, err := diagnosticNonce()
+	if err != nil {
+		report.fail(ProblemUnknown, err)
+		return report
+	}
+
+	start := time.Now()
+	echoed, resp, err := c.Test.Echo(ctx, map[string]string{"nonce": nonce})
+	report.Latency = time.Since(start)
+	if resp != nil {
+		report.Reachable = true
+		report.ClockSkew = clockSkew(resp.Response, start, report.Latency)
+	}
+	if err != nil {
+		report.fail(classifyDiagnosticError(resp, err), err)
+		return report
+	}
+	if echoed["nonce"] != nonce {
+		report.fail(ProblemUnknown, fmt.Errorf("rtm.test.echo returned nonce %q, want %q", echoed["nonce"], nonce))
+		return report
+	}
+	report.SignatureValid = true
+
+	token := c.authentication()
+	if len(token) == 0 {
+		report.fail(ProblemToken, errors.New("no authentication token set"))
+		return report
+	}
+
+	auth, resp, err := c.Authentication.checkToken(ctx, token)
+	if err != nil {
+		report.fail(classifyDiagnosticError(resp, err), err)
+		return report
+	}
+	report.TokenValid = true
+	report.Permissions = auth.Permissions
+	report.Username = auth.User.Username
+
+	return report
+}
+
+func (r *DiagnosticReport) fail(problem DiagnosticProblem, err error) {
+	r.Problem = problem
+	r.Err = err
+}
+
+// classifyDiagnosticError maps the error of an API call to a DiagnosticProblem.
+func classifyDiagnosticError(resp *Response, err error) DiagnosticProblem {
+	var errorResponse *ErrorResponse
+	switch {
+	case !errors.As(err, &errorResponse) && resp == nil:
+		return ProblemNetwork
+	case errors.Is(err, ErrInvalidSignature), errors.Is(err, ErrMissingSignature):
+		return ProblemSharedSecret
+	case errors.Is(err, ErrInvalidToken):
+		return ProblemToken
+	case errors.Is(err, ErrInvalidAPIKey):
+		return ProblemAPIKey
+	case errors.Is(err, ErrServiceUnavailable), errors.Is(err, ErrRateLimited):
+		return ProblemService
+	}
+	return ProblemUnknown
+}
+
+// clockSkew compares the Date header of r with the local time in the middle of the round trip.
+func clockSkew(r *http.Response, start time.Time, latency time.Duration) time.Duration {
+	if r == nil {
+		return 0
+	}
+	serverTime, err := http.ParseTime(r.Header.Get("Date"))
+	if err != nil {
+		return 0
+	}
+	return serverTime.Sub(start.Add(latency / 2)).Truncate(time.Second)
+}
+
+func diagnosticNonce() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
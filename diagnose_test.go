@@ -0,0 +1,94 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Diagnose(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       string
+		echo        string
+		checkToken  string
+		wantProblem DiagnosticProblem
+		wantToken   bool
+	}{
+		{
+			name:        "healthy",
+			token:       "token",
+			checkToken:  `{"rsp":{"stat":"ok","auth":{"token":"token","perms":"delete","user":{"id":"1","username":"bob","fullname":"Bob T. Monkey"}}}}`,
+			wantProblem: ProblemNone,
+			wantToken:   true,
+		},
+		{
+			name:        "invalid api key",
+			token:       "token",
+			echo:        `{"rsp":{"stat":"fail","err":{"code":"100","msg":"Invalid API Key"}}}`,
+			wantProblem: ProblemAPIKey,
+		},
+		{
+			name:        "invalid signature",
+			token:       "token",
+			echo:        `{"rsp":{"stat":"fail","err":{"code":"96","msg":"Invalid signature"}}}`,
+			wantProblem: ProblemSharedSecret,
+		},
+		{
+			name:        "missing token",
+			wantProblem: ProblemToken,
+		},
+		{
+			name:        "invalid token",
+			token:       "token",
+			checkToken:  `{"rsp":{"stat":"fail","err":{"code":"98","msg":"Login failed / Invalid auth token"}}}`,
+			wantProblem: ProblemToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+			client.SetAuthenticationToken(tt.token)
+
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Query().Get("method") {
+				case "rtm.test.echo":
+					if tt.echo != "" {
+						fmt.Fprint(w, tt.echo)
+						return
+					}
+					fmt.Fprintf(w, `{"rsp":{"stat":"ok","method":"rtm.test.echo","nonce":%q}}`, r.URL.Query().Get("nonce"))
+				case "rtm.auth.checkToken":
+					fmt.Fprint(w, tt.checkToken)
+				}
+			})
+
+			report := client.Diagnose(context.Background())
+
+			if !report.Reachable {
+				t.Error("Diagnose reported the API as unreachable")
+			}
+			if report.Problem != tt.wantProblem {
+				t.Errorf("Diagnose reported problem %q (%v), want %q", report.Problem, report.Err, tt.wantProblem)
+			}
+			if report.TokenValid != tt.wantToken {
+				t.Errorf("Diagnose reported TokenValid %v, want %v", report.TokenValid, tt.wantToken)
+			}
+			if tt.wantToken && report.Permissions != PermissionDelete {
+				t.Errorf("Diagnose reported permissions %q, want %q", report.Permissions, PermissionDelete)
+			}
+		})
+	}
+}
+
+func TestClient_Diagnose_network(t *testing.T) {
+	client, _ := setup(t)
+	client.BaseURL.Host = "127.0.0.1:1"
+
+	report := client.Diagnose(context.Background())
+	if report.Reachable || report.Problem != ProblemNetwork {
+		t.Errorf("Diagnose reported reachable %v and problem %q, want an unreachable API and problem %q", report.Reachable, report.Problem, ProblemNetwork)
+	}
+}
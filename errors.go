@@ -0,0 +1,70 @@
+package rememberthemilk
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+)
+
+// Errors reported by the Remember The Milk API. An *ErrorResponse matches
+// them with errors.Is, e.g. errors.Is(err, ErrInvalidToken).
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/response.rtm
+var (
+	// ErrInvalidSignature is returned for error code 96 (Invalid signature).
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrMissingSignature is returned for error code 97 (Missing signature).
+	ErrMissingSignature = errors.New("missing signature")
+	// ErrInvalidToken is returned for error code 98 (Login failed / Invalid auth token).
+	ErrInvalidToken = errors.New("login failed / invalid auth token")
+	// ErrInvalidAPIKey is returned for error code 100 (Invalid API Key).
+	ErrInvalidAPIKey = errors.New("invalid API key")
+	// ErrInvalidFrob is returned for error code 101 (Invalid frob).
+	// It is also returned while the user has not approved the frob yet.
+	ErrInvalidFrob = errors.New("invalid frob")
+	// ErrServiceUnavailable is returned for error code 105 (Service currently unavailable).
+	ErrServiceUnavailable = errors.New("service currently unavailable")
+	// ErrMethodNotFound is returned for error code 112 (Method not found).
+	ErrMethodNotFound = errors.New("method not found")
+	// ErrInvalidTimeline is returned for error code 300 (Timeline invalid or not provided).
+	ErrInvalidTimeline = errors.New("timeline invalid or not provided")
+	// ErrTaskNotFound is returned for error code 340 (list_id/taskseries_id/task_id invalid or not provided).
+	ErrTaskNotFound = errors.New("task not found")
+
+	// ErrRateLimited is returned if the API answers with HTTP 503 Service Unavailable,
+	// which means the rate limit has been exceeded.
+	// See https://www.rememberthemilk.com/services/api/ratelimit.rtm
+	ErrRateLimited = errors.New("rate limit exceeded")
+)
+
+// Categories of the errors reported by the Remember The Milk API.
+var (
+	// ErrAuthentication matches all errors caused by the API key, the shared secret,
+	// the authentication token or its permissions.
+	ErrAuthentication = errors.New("authentication failed")
+
+	// ErrNotFound matches all errors caused by unknown timelines or tasks.
+	ErrNotFound = errors.New("not found")
+)
+
+// errorCodes maps the error codes of the API to the errors an *ErrorResponse matches.
+// Error code 99 (User not logged in / Insufficient permissions) matches ErrInsufficientPermission,
+// like the local permission check does.
+var errorCodes = map[int][]error{
+	96:                            {ErrInvalidSignature, ErrAuthentication},
+	97:                            {ErrMissingSignature, ErrAuthentication},
+	98:                            {ErrInvalidToken, ErrAuthentication},
+	99:                            {ErrInsufficientPermission, ErrAuthentication},
+	100:                           {ErrInvalidAPIKey, ErrAuthentication},
+	101:                           {ErrInvalidFrob, ErrAuthentication},
+	105:                           {ErrServiceUnavailable},
+	112:                           {ErrMethodNotFound},
+	300:                           {ErrInvalidTimeline, ErrNotFound},
+	340:                           {ErrTaskNotFound, ErrNotFound},
+	http.StatusServiceUnavailable: {ErrRateLimited},
+}
+
+// Is reports whether the error code of r corresponds to target, one of the Err* variables.
+func (r *ErrorResponse) Is(target error) bool {
+	return slices.Contains(errorCodes[r.Code], target)
+}
@@ -0,0 +1,53 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestErrorResponse_Is(t *testing.T) {
+	tests := []struct {
+		code    int
+		want    []error
+		notWant []error
+	}{
+		{code: 96, want: []error{ErrInvalidSignature, ErrAuthentication}, notWant: []error{ErrInvalidToken, ErrNotFound}},
+		{code: 98, want: []error{ErrInvalidToken, ErrAuthentication}, notWant: []error{ErrRateLimited}},
+		{code: 99, want: []error{ErrInsufficientPermission, ErrAuthentication}},
+		{code: 340, want: []error{ErrTaskNotFound, ErrNotFound}, notWant: []error{ErrAuthentication}},
+		{code: http.StatusServiceUnavailable, want: []error{ErrRateLimited}, notWant: []error{ErrServiceUnavailable}},
+		{code: 4711, notWant: []error{ErrAuthentication, ErrNotFound, ErrRateLimited}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.code), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", &ErrorResponse{Code: tt.code})
+			for _, target := range tt.want {
+				if !errors.Is(err, target) {
+					t.Errorf("errors.Is(%d, %v) = false, want true", tt.code, target)
+				}
+			}
+			for _, target := range tt.notWant {
+				if errors.Is(err, target) {
+					t.Errorf("errors.Is(%d, %v) = true, want false", tt.code, target)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckResponse_errorCodes(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rsp":{"stat":"fail","err":{"code":"98","msg":"Login failed / Invalid auth token"}}}`)
+	})
+
+	_, _, err := client.Lists.GetList(context.Background())
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Lists.GetList returned error %v, want %v", err, ErrInvalidToken)
+	}
+}
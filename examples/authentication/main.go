@@ -1,10 +1,9 @@
 package main
 
 import (
-	"bufio"
 	"context"
 	"fmt"
-	"os"
+	"time"
 
 	"github.com/andygrunwald/go-rememberthemilk"
 )
@@ -16,37 +15,35 @@ func main() {
 	// Initialize the client without an authentication token.
 	client := rememberthemilk.NewClient(apiKey, sharedSecret, "", nil)
 
-	// Step 1: Get a frob
-	frob, _, err := client.Authentication.GetFrob(context.Background())
-	if err != nil {
-		panic(err)
-	}
-	fmt.Printf("Frob: %s\n", frob)
-
-	// Step 2: Get the authentication URL and prompt the user to visit it
-	address, err := client.Authentication.GetAuthenticationURLWithFrob(rememberthemilk.PermissionWrite, frob)
-	if err != nil {
-		panic(err)
+	// The Authenticator requests a frob, opens the authentication URL in the browser
+	// and polls for the authentication token until the user approved the application.
+	authenticator := &rememberthemilk.Authenticator{
+		Client:     client,
+		Permission: rememberthemilk.PermissionWrite,
+		Opener: func(address string) error {
+			fmt.Println("Please authenticate the application in your browser.")
+			fmt.Println("If no browser opens, visit the following URL:")
+			fmt.Println(address)
+
+			// On a headless machine no browser can be opened, but the user can still
+			// visit the printed URL. Keep polling instead of giving up.
+			if err := rememberthemilk.OpenBrowser(address); err != nil {
+				fmt.Printf("Could not open a browser: %v\n", err)
+			}
+			return nil
+		},
 	}
-	fmt.Println("Please visit the following URL to authenticate the application:")
-	fmt.Println(address)
 
-	fmt.Println("Press Enter after you have authenticated...")
-	reader := bufio.NewReader(os.Stdin)
-	_, _, err = reader.ReadRune()
-	if err != nil {
-		panic(err)
-	}
+	// Give the user five minutes to approve the application.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
 
-	// Step 3: Get the authentication token using the frob
-	token, _, err := client.Authentication.GetToken(context.Background(), frob)
+	token, err := authenticator.Authenticate(ctx)
 	if err != nil {
 		panic(err)
 	}
 	fmt.Printf("Authentication successful! Token: %s\n", token.Token)
 
-	// Set the authentication token in the client for future requests
-	client.SetAuthenticationToken(token.Token)
-
+	// The authentication token is set in the client for future requests.
 	// Now you can use the client with the authenticated token.
 }
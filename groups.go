@@ -0,0 +1,151 @@
+package rememberthemilk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// GroupsService handles communication with the contact group related
+// methods of the Remember The Milk API.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods.rtm
+type GroupsService service
+
+type GroupsGetListResponse struct {
+	Groups GroupList `json:"groups"`
+
+	BaseResponse
+}
+
+type GroupList struct {
+	Group []Group `json:"group"`
+}
+
+// Group represents a group of contacts.
+// The members are referenced by their Contact.ID, see ContactsService.GetList.
+type Group struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	ContactIDs []string `json:"contacts"`
+}
+
+// UnmarshalJSON decodes a group and unwraps the IDs of its contacts.
+// See unmarshalCollection for the format.
+func (g *Group) UnmarshalJSON(data []byte) error {
+	type group Group
+	aux := struct {
+		*group
+		Contacts json.RawMessage `json:"contacts"`
+	}{
+		group: (*group)(g),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	contacts, err := unmarshalCollection[Contact](aux.Contacts, "contact")
+	if err != nil {
+		return err
+	}
+	g.ContactIDs = nil
+	for _, contact := range contacts {
+		g.ContactIDs = append(g.ContactIDs, contact.ID)
+	}
+	return nil
+}
+
+type GroupAddInput struct {
+	Timeline string `url:"timeline,omitempty"`
+	Name     string `url:"group"`
+
+	BaseAPIURLOptions
+}
+
+// GroupModifyInput identifies the group a modifying method of the GroupsService operates on.
+type GroupModifyInput struct {
+	Timeline string `url:"timeline,omitempty"`
+	GroupID  string `url:"group_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+type GroupContactInput struct {
+	GroupModifyInput
+
+	ContactID string `url:"contact_id,omitempty"`
+}
+
+type GroupModifyResponse struct {
+	Transaction Transaction `json:"transaction"`
+	Group       Group       `json:"group"`
+
+	BaseResponse
+}
+
+// GetList retrieves a list of groups.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.groups.getList.rtm
+func (s *GroupsService) GetList(ctx context.Context) ([]Group, *Response, error) {
+	opts := s.client.addBaseAPIURLOptions("rtm.groups.getList")
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response struct {
+			GroupsGetListResponse
+		} `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return apiResponse.Response.Groups.Group, resp, nil
+}
+
+// Add creates a new group.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.groups.add.rtm
+func (s *GroupsService) Add(ctx context.Context, group GroupAddInput) (*GroupModifyResponse, *Response, error) {
+	group.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.groups.add")
+	return doAPI[GroupModifyResponse](ctx, s.client, group)
+}
+
+// Delete deletes a group. The returned GroupModifyResponse only carries the transaction.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.groups.delete.rtm
+func (s *GroupsService) Delete(ctx context.Context, group GroupModifyInput) (*GroupModifyResponse, *Response, error) {
+	group.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.groups.delete")
+	return doAPI[GroupModifyResponse](ctx, s.client, group)
+}
+
+// AddContact adds a contact to a group.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.groups.addContact.rtm
+func (s *GroupsService) AddContact(ctx context.Context, group GroupContactInput) (*GroupModifyResponse, *Response, error) {
+	group.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.groups.addContact")
+	return doAPI[GroupModifyResponse](ctx, s.client, group)
+}
+
+// RemoveContact removes a contact from a group.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.groups.removeContact.rtm
+func (s *GroupsService) RemoveContact(ctx context.Context, group GroupContactInput) (*GroupModifyResponse, *Response, error) {
+	group.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.groups.removeContact")
+	return doAPI[GroupModifyResponse](ctx, s.client, group)
+}
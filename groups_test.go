@@ -0,0 +1,144 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGroupsService_GetList(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.groups.getList")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","groups":{"group":[
+			{"id":"987654321","name":"Friends","contacts":{"contact":[{"id":"1"},{"id":"2"}]}},
+			{"id":"987654322","name":"Empty","contacts":[]}
+		]}}}`)
+	})
+
+	groups, _, err := client.Groups.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Groups.GetList returned error: %v", err)
+	}
+
+	want := []Group{
+		{ID: "987654321", Name: "Friends", ContactIDs: []string{"1", "2"}},
+		{ID: "987654322", Name: "Empty"},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Groups.GetList returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestGroupsService_Add(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.groups.add")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "group", "Friends")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"0"},
+			"group":{"id":"987654321","name":"Friends","contacts":[]}}}`)
+	})
+
+	input := GroupAddInput{
+		Timeline: "12741021",
+		Name:     "Friends",
+	}
+	result, _, err := client.Groups.Add(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Groups.Add returned error: %v", err)
+	}
+
+	want := Group{ID: "987654321", Name: "Friends"}
+	if !reflect.DeepEqual(result.Group, want) {
+		t.Errorf("Groups.Add returned %+v, want %+v", result.Group, want)
+	}
+}
+
+func TestGroupsService_Delete(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.groups.delete")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "group_id", "987654321")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4712","undoable":"0"}}}`)
+	})
+
+	input := GroupModifyInput{
+		Timeline: "12741021",
+		GroupID:  "987654321",
+	}
+	result, _, err := client.Groups.Delete(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Groups.Delete returned error: %v", err)
+	}
+
+	if result.Transaction.ID != "4712" {
+		t.Errorf("Groups.Delete returned transaction %q, want %q", result.Transaction.ID, "4712")
+	}
+}
+
+func TestGroupsService_AddContact(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.groups.addContact")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "group_id", "987654321")
+		testParam(t, r, "contact_id", "1")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4713","undoable":"0"},
+			"group":{"id":"987654321","name":"Friends","contacts":{"contact":[{"id":"1"}]}}}}`)
+	})
+
+	input := GroupContactInput{
+		GroupModifyInput: GroupModifyInput{
+			Timeline: "12741021",
+			GroupID:  "987654321",
+		},
+		ContactID: "1",
+	}
+	result, _, err := client.Groups.AddContact(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Groups.AddContact returned error: %v", err)
+	}
+
+	want := Group{ID: "987654321", Name: "Friends", ContactIDs: []string{"1"}}
+	if !reflect.DeepEqual(result.Group, want) {
+		t.Errorf("Groups.AddContact returned %+v, want %+v", result.Group, want)
+	}
+}
+
+func TestGroupsService_RemoveContact(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.groups.removeContact")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "group_id", "987654321")
+		testParam(t, r, "contact_id", "1")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4714","undoable":"0"},
+			"group":{"id":"987654321","name":"Friends","contacts":[]}}}`)
+	})
+
+	input := GroupContactInput{
+		GroupModifyInput: GroupModifyInput{
+			Timeline: "12741021",
+			GroupID:  "987654321",
+		},
+		ContactID: "1",
+	}
+	result, _, err := client.Groups.RemoveContact(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Groups.RemoveContact returned error: %v", err)
+	}
+
+	want := Group{ID: "987654321", Name: "Friends"}
+	if !reflect.DeepEqual(result.Group, want) {
+		t.Errorf("Groups.RemoveContact returned %+v, want %+v", result.Group, want)
+	}
+}
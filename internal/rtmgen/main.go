@@ -0,0 +1,244 @@
+// Command rtmgen generates the method parameter structs of the
+// Remember The Milk API from a snapshot of the reflection API.
+//
+// It is invoked by go generate in the root package:
+//
+//	go generate ./...
+//
+// To refresh the snapshot from the live API, run it with -refresh and
+// the environment variables RTM_API_KEY and RTM_SHARED_SECRET set:
+//
+//	go run ./internal/rtmgen -refresh
+//
+// The committed snapshot has not been fetched from the live API yet. It was
+// transcribed from the public API documentation and lacks the errors and response
+// of each method. Replace it with the output of -refresh before relying on the
+// required permissions, which the client uses to reject calls locally.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/andygrunwald/go-rememberthemilk"
+)
+
+// baseArguments are covered by rememberthemilk.BaseAPIURLOptions
+// or are not supported by the client.
+var baseArguments = map[string]bool{
+	"api_key":    true,
+	"api_sig":    true,
+	"auth_token": true,
+	"callback":   true,
+	"format":     true,
+	"method":     true,
+	"v":          true,
+}
+
+// initialisms are written in upper case in Go identifiers.
+var initialisms = map[string]string{
+	"api": "API",
+	"id":  "ID",
+	"url": "URL",
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+func main() {
+	snapshot := flag.String("snapshot", "internal/rtmgen/methods.json", "Path of the reflection snapshot")
+	out := flag.String("out", "methods_gen.go", "Path of the generated Go file")
+	refresh := flag.Bool("refresh", false, "Refresh the snapshot from the live API before generating")
+	flag.Parse()
+
+	if *refresh {
+		if err := refreshSnapshot(*snapshot); err != nil {
+			log.Fatalf("refreshing snapshot: %v", err)
+		}
+	}
+
+	methods, err := readSnapshot(*snapshot)
+	if err != nil {
+		log.Fatalf("reading snapshot: %v", err)
+	}
+
+	src, err := generate(methods)
+	if err != nil {
+		log.Fatalf("generating code: %v", err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatalf("writing %s: %v", *out, err)
+	}
+}
+
+// refreshSnapshot fetches the description of all API methods and writes them to path.
+func refreshSnapshot(path string) error {
+	apiKey, sharedSecret := os.Getenv("RTM_API_KEY"), os.Getenv("RTM_SHARED_SECRET")
+	if apiKey == "" || sharedSecret == "" {
+		return fmt.Errorf("RTM_API_KEY and RTM_SHARED_SECRET must be set")
+	}
+
+	ctx := context.Background()
+	client := rememberthemilk.NewClient(apiKey, sharedSecret, "", nil)
+	names, _, err := client.Reflection.GetMethods(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	methods := make([]rememberthemilk.MethodInfo, 0, len(names))
+	for _, name := range names {
+		method, _, err := client.Reflection.GetMethodInfo(ctx, name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		methods = append(methods, *method)
+	}
+
+	data, err := json.MarshalIndent(methods, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func readSnapshot(path string) ([]rememberthemilk.MethodInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []rememberthemilk.MethodInfo
+	if err := json.Unmarshal(data, &methods); err != nil {
+		return nil, err
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].Name < methods[j].Name
+	})
+	return methods, nil
+}
+
+type method struct {
+	Name        string
+	TypeName    string
+	Description string
+	Permission  string
+	Fields      []field
+}
+
+type field struct {
+	Name        string
+	Tag         string
+	Description string
+}
+
+func generate(infos []rememberthemilk.MethodInfo) ([]byte, error) {
+	methods := make([]method, 0, len(infos))
+	for _, info := range infos {
+		m := method{
+			Name:        info.Name,
+			TypeName:    goName(strings.TrimPrefix(info.Name, "rtm."), ".") + "Params",
+			Description: comment(info.Description),
+			Permission:  permissionConstant(info.Permission()),
+		}
+		for _, arg := range info.Arguments {
+			if baseArguments[arg.Name] {
+				continue
+			}
+			tag := arg.Name
+			if arg.Optional == "1" {
+				tag += ",omitempty"
+			}
+			m.Fields = append(m.Fields, field{
+				Name:        goName(arg.Name, "_"),
+				Tag:         tag,
+				Description: comment(arg.Description),
+			})
+		}
+		methods = append(methods, m)
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, methods); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// goName converts a name like "tasks.notes.add" or "list_id" into a Go identifier like "TasksNotesAdd" or "ListID".
+func goName(s, sep string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(s, sep) {
+		if part == "" {
+			continue
+		}
+		if initialism, ok := initialisms[strings.ToLower(part)]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// permissionConstant returns the name of the Permission* constant of permission.
+func permissionConstant(permission string) string {
+	switch permission {
+	case rememberthemilk.PermissionRead:
+		return "PermissionRead"
+	case rememberthemilk.PermissionWrite:
+		return "PermissionWrite"
+	case rememberthemilk.PermissionDelete:
+		return "PermissionDelete"
+	}
+	return ""
+}
+
+// comment strips HTML from a description of the reflection API and collapses its whitespace.
+func comment(s string) string {
+	s = htmlTag.ReplaceAllString(s, "")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by rtmgen from internal/rtmgen/methods.json. DO NOT EDIT.
+
+package rememberthemilk
+
+// apiMethods describes every method of the Remember The Milk API.
+var apiMethods = map[string]apiMethod{
+{{- range .}}
+	"{{.Name}}": { {{- if .Permission}}permission: {{.Permission}}{{end -}} },
+{{- end}}
+}
+{{range .}}
+// {{.TypeName}} holds the arguments of {{.Name}} for Client.Call.
+// If a service provides a method for {{.Name}}, prefer that one.
+{{- if .Description}}
+//
+// {{.Description}}
+{{- end}}
+type {{.TypeName}} struct {
+{{- range .Fields}}
+{{- if .Description}}
+	// {{.Description}}
+{{- end}}
+	{{.Name}} string ` + "`url:\"{{.Tag}}\"`" + `
+{{- end}}
+{{if .Fields}}
+{{end}}	BaseAPIURLOptions
+}
+
+func (*{{.TypeName}}) rtmMethod() string {
+	return "{{.Name}}"
+}
+{{end}}`))
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/andygrunwald/go-rememberthemilk"
+)
+
+// TestGeneratedFileUpToDate fails if methods_gen.go differs from what the snapshot generates.
+// Run go generate in the repository root to fix it.
+func TestGeneratedFileUpToDate(t *testing.T) {
+	methods, err := readSnapshot("methods.json")
+	if err != nil {
+		t.Fatalf("readSnapshot returned error: %v", err)
+	}
+
+	want, err := generate(methods)
+	if err != nil {
+		t.Fatalf("generate returned error: %v", err)
+	}
+
+	got, err := os.ReadFile("../../methods_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("methods_gen.go is out of date, run go generate")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := []struct {
+		in, sep, want string
+	}{
+		{"tasks.notes.add", ".", "TasksNotesAdd"},
+		{"list_id", "_", "ListID"},
+		{"has_due_time", "_", "HasDueTime"},
+		{"url", "_", "URL"},
+	}
+	for _, tt := range tests {
+		if got := goName(tt.in, tt.sep); got != tt.want {
+			t.Errorf("goName(%q, %q) = %q, want %q", tt.in, tt.sep, got, tt.want)
+		}
+	}
+}
+
+// TestSnapshotPermissions checks the required permissions of the snapshot
+// against the conventions of the API documentation.
+func TestSnapshotPermissions(t *testing.T) {
+	methods, err := readSnapshot("methods.json")
+	if err != nil {
+		t.Fatalf("readSnapshot returned error: %v", err)
+	}
+
+	for _, m := range methods {
+		if strings.HasSuffix(m.Name, ".delete") && m.Permission() != rememberthemilk.PermissionDelete {
+			t.Errorf("%s requires permission %q, want %q", m.Name, m.Permission(), rememberthemilk.PermissionDelete)
+		}
+	}
+}
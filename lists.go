@@ -28,10 +28,43 @@ type List struct {
 	Archived   string `json:"archived"`
 	Position   string `json:"position"`
 	Smart      string `json:"smart"`
+	Filter     string `json:"filter,omitempty"`
 	SortOrder  string `json:"sort_order"`
 	Permission string `json:"permission"`
 }
 
+type ListAddInput struct {
+	Timeline string `url:"timeline,omitempty"`
+	Name     string `url:"name"`
+
+	// Filter turns the new list into a Smart List.
+	// It is a search query in the Remember The Milk search syntax, e.g. "tag:sprint-42".
+	Filter string `url:"filter,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+// ListModifyInput identifies the list a modifying method of the ListService operates on.
+type ListModifyInput struct {
+	Timeline string `url:"timeline,omitempty"`
+	ListID   string `url:"list_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+type ListSetNameInput struct {
+	ListModifyInput
+
+	Name string `url:"name"`
+}
+
+type ListModifyResponse struct {
+	Transaction Transaction `json:"transaction"`
+	List        List        `json:"list"`
+
+	BaseResponse
+}
+
 // GetList retrieves a list of lists.
 //
 // Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.lists.getList.rtm
@@ -59,3 +92,64 @@ func (s *ListService) GetList(ctx context.Context) ([]List, *Response, error) {
 
 	return apiResponse.Response.ListsGetListResponse.Lists.List, resp, nil
 }
+
+// Add creates a new list. If ListAddInput.Filter is provided, a Smart List is created.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.lists.add.rtm
+func (s *ListService) Add(ctx context.Context, list ListAddInput) (*ListModifyResponse, *Response, error) {
+	list.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.lists.add")
+	return doAPI[ListModifyResponse](ctx, s.client, list)
+}
+
+// Delete deletes a list.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.lists.delete.rtm
+func (s *ListService) Delete(ctx context.Context, list ListModifyInput) (*ListModifyResponse, *Response, error) {
+	list.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.lists.delete")
+	return doAPI[ListModifyResponse](ctx, s.client, list)
+}
+
+// Archive archives a list.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.lists.archive.rtm
+func (s *ListService) Archive(ctx context.Context, list ListModifyInput) (*ListModifyResponse, *Response, error) {
+	list.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.lists.archive")
+	return doAPI[ListModifyResponse](ctx, s.client, list)
+}
+
+// Unarchive unarchives a list.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.lists.unarchive.rtm
+func (s *ListService) Unarchive(ctx context.Context, list ListModifyInput) (*ListModifyResponse, *Response, error) {
+	list.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.lists.unarchive")
+	return doAPI[ListModifyResponse](ctx, s.client, list)
+}
+
+// SetName renames a list.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.lists.setName.rtm
+func (s *ListService) SetName(ctx context.Context, list ListSetNameInput) (*ListModifyResponse, *Response, error) {
+	list.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.lists.setName")
+	return doAPI[ListModifyResponse](ctx, s.client, list)
+}
+
+// SetDefaultList sets the default list, to which tasks are added if no list is specified.
+// The returned ListModifyResponse only carries the transaction.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.lists.setDefaultList.rtm
+func (s *ListService) SetDefaultList(ctx context.Context, list ListModifyInput) (*ListModifyResponse, *Response, error) {
+	list.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.lists.setDefaultList")
+	return doAPI[ListModifyResponse](ctx, s.client, list)
+}
@@ -0,0 +1,35 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListService_Add(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.lists.add")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "name", "Sprint 42")
+		testParam(t, r, "filter", "tag:sprint-42")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"0"},
+			"list":{"id":"987654321","name":"Sprint 42","deleted":"0","locked":"0","archived":"0","position":"0","smart":"1","filter":"tag:sprint-42"}}}`)
+	})
+
+	input := ListAddInput{
+		Timeline: "12741021",
+		Name:     "Sprint 42",
+		Filter:   "tag:sprint-42",
+	}
+	result, _, err := client.Lists.Add(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Lists.Add returned error: %v", err)
+	}
+
+	if result.List.ID != "987654321" || result.List.Smart != "1" || result.List.Filter != "tag:sprint-42" {
+		t.Errorf("Lists.Add returned %+v, want smart list 987654321", result.List)
+	}
+}
@@ -0,0 +1,105 @@
+package rememberthemilk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// LocationsService handles communication with the location related
+// methods of the Remember The Milk API.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods.rtm
+type LocationsService service
+
+type LocationsGetListResponse struct {
+	Locations LocationList `json:"locations"`
+
+	BaseResponse
+}
+
+type LocationList struct {
+	Location []Location `json:"location"`
+}
+
+// Location represents a location tasks can be assigned to. See Taskseries.LocationID.
+type Location struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	Zoom      int     `json:"zoom"`
+	Address   string  `json:"address"`
+	Viewable  bool    `json:"viewable"`
+}
+
+// UnmarshalJSON decodes a location. The API is not consistent whether it
+// returns the numeric fields as numbers or as strings, so both are accepted.
+func (l *Location) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		ID        string      `json:"id"`
+		Name      string      `json:"name"`
+		Longitude json.Number `json:"longitude"`
+		Latitude  json.Number `json:"latitude"`
+		Zoom      json.Number `json:"zoom"`
+		Address   string      `json:"address"`
+		Viewable  json.Number `json:"viewable"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	*l = Location{
+		ID:       aux.ID,
+		Name:     aux.Name,
+		Address:  aux.Address,
+		Viewable: aux.Viewable == "1",
+	}
+
+	var err error
+	if aux.Longitude != "" {
+		if l.Longitude, err = aux.Longitude.Float64(); err != nil {
+			return err
+		}
+	}
+	if aux.Latitude != "" {
+		if l.Latitude, err = aux.Latitude.Float64(); err != nil {
+			return err
+		}
+	}
+	if aux.Zoom != "" {
+		zoom, err := aux.Zoom.Int64()
+		if err != nil {
+			return err
+		}
+		l.Zoom = int(zoom)
+	}
+	return nil
+}
+
+// GetList retrieves a list of locations.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.locations.getList.rtm
+func (s *LocationsService) GetList(ctx context.Context) ([]Location, *Response, error) {
+	opts := s.client.addBaseAPIURLOptions("rtm.locations.getList")
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response struct {
+			LocationsGetListResponse
+		} `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return apiResponse.Response.Locations.Location, resp, nil
+}
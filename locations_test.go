@@ -0,0 +1,34 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestLocationsService_GetList(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.locations.getList")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","locations":{"location":[
+			{"id":"987654321","name":"Berlin","longitude":13.411508,"latitude":52.524008,"zoom":9,"address":"Berlin, Germany","viewable":"1"},
+			{"id":"987654322","name":"Sydney","longitude":"151.208792","latitude":"-33.869453","zoom":"10","address":"Sydney, Australia","viewable":0}
+		]}}}`)
+	})
+
+	locations, _, err := client.Locations.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Locations.GetList returned error: %v", err)
+	}
+
+	want := []Location{
+		{ID: "987654321", Name: "Berlin", Longitude: 13.411508, Latitude: 52.524008, Zoom: 9, Address: "Berlin, Germany", Viewable: true},
+		{ID: "987654322", Name: "Sydney", Longitude: 151.208792, Latitude: -33.869453, Zoom: 10, Address: "Sydney, Australia"},
+	}
+	if !reflect.DeepEqual(locations, want) {
+		t.Errorf("Locations.GetList returned %+v, want %+v", locations, want)
+	}
+}
@@ -0,0 +1,85 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// redactedParams are the query parameters which are replaced in logged URLs,
+// because they contain credentials or can be exchanged for them.
+var redactedParams = []string{"api_key", "api_sig", "auth_token", "frob"}
+
+// logCalls returns a Middleware which logs every call passing through it to logger.
+// It is used as attempt middleware, so retried attempts are logged as well.
+// Successful attempts are logged at level Info, failed attempts at level Warn.
+func logCalls(logger *slog.Logger) Middleware {
+	return func(next CallHandler) CallHandler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, call)
+
+			attrs := []slog.Attr{
+				slog.String("method", call.Method),
+				slog.Int("attempt", call.Attempt),
+				slog.Duration("duration", time.Since(start)),
+				slog.String("url", redactURL(call.Request.URL)),
+			}
+			if resp != nil {
+				attrs = append(attrs,
+					slog.Int("status", resp.StatusCode),
+					slog.String("stat", resp.Stat),
+				)
+			}
+			if err == nil {
+				logger.LogAttrs(ctx, slog.LevelInfo, "rtm call", attrs...)
+				return resp, nil
+			}
+
+			var errorResponse *ErrorResponse
+			if errors.As(err, &errorResponse) {
+				attrs = append(attrs, slog.Int("code", errorResponse.Code))
+			}
+			attrs = append(attrs, slog.String("error", redactError(err)))
+			logger.LogAttrs(ctx, slog.LevelWarn, "rtm call failed", attrs...)
+			return resp, err
+		}
+	}
+}
+
+// redactURL returns u with the values of redactedParams replaced.
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	redacted := *u
+	redacted.RawQuery = redactQuery(u.Query()).Encode()
+	return redacted.String()
+}
+
+func redactQuery(params url.Values) url.Values {
+	for _, key := range redactedParams {
+		if params.Has(key) {
+			params.Set(key, "REDACTED")
+		}
+	}
+	return params
+}
+
+// redactError returns the message of err. Transport errors contain the request URL,
+// which is redacted.
+func redactError(err error) string {
+	var urlError *url.Error
+	if !errors.As(err, &urlError) {
+		return err.Error()
+	}
+	u, parseErr := url.Parse(urlError.URL)
+	if parseErr != nil {
+		return urlError.Op + ": " + urlError.Err.Error()
+	}
+	redacted := *urlError
+	redacted.URL = redactURL(u)
+	return redacted.Error()
+}
@@ -0,0 +1,103 @@
+package rememberthemilk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Logger(t *testing.T) {
+	client, mux := setup(t)
+	var buf bytes.Buffer
+	client.Logger = slog.New(slog.NewJSONHandler(&buf, nil))
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rsp":{"stat":"fail","err":{"code":"98","msg":"Login failed / Invalid auth token"}}}`)
+	})
+
+	_, _, err := client.Authentication.GetToken(context.Background(), "secret-frob")
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Authentication.GetToken returned error %v, want %v", err, ErrInvalidToken)
+	}
+
+	var entry struct {
+		Level  string `json:"level"`
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Status int    `json:"status"`
+		Stat   string `json:"stat"`
+		Code   int    `json:"code"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Log entry %q is not JSON: %v", buf.String(), err)
+	}
+
+	if entry.Level != "WARN" || entry.Method != "rtm.auth.getToken" || entry.Status != http.StatusOK || entry.Stat != StatFail || entry.Code != 98 {
+		t.Errorf("Log entry = %+v, want level WARN, method rtm.auth.getToken, status 200, stat fail and code 98", entry)
+	}
+	for _, secret := range []string{"api-key", "token", "secret-frob", client.SignRequest(url.Values{})} {
+		if strings.Contains(buf.String(), "="+secret) {
+			t.Errorf("Log entry %q contains %q", buf.String(), secret)
+		}
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	err := &url.Error{
+		Op:  "Get",
+		URL: "https://api.rememberthemilk.com/services/rest/?api_key=key&auth_token=token&method=rtm.test.echo",
+		Err: errors.New("connection refused"),
+	}
+
+	want := `Get "https://api.rememberthemilk.com/services/rest/?api_key=REDACTED&auth_token=REDACTED&method=rtm.test.echo": connection refused`
+	if got := redactError(err); got != want {
+		t.Errorf("redactError() = %v, want %v", got, want)
+	}
+}
+
+func TestClient_Logger_retries(t *testing.T) {
+	client, mux := setup(t)
+	var buf bytes.Buffer
+	client.Logger = slog.New(slog.NewJSONHandler(&buf, nil))
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	requests := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","lists":{"list":[]}}}`)
+	})
+
+	if _, _, err := client.Lists.GetList(context.Background()); err != nil {
+		t.Fatalf("Lists.GetList returned error: %v", err)
+	}
+
+	var got []string
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var entry struct {
+			Level   string `json:"level"`
+			Attempt int    `json:"attempt"`
+			Status  int    `json:"status"`
+		}
+		if err := dec.Decode(&entry); err != nil {
+			t.Fatalf("Log entry is not JSON: %v", err)
+		}
+		got = append(got, fmt.Sprintf("%s %d %d", entry.Level, entry.Attempt, entry.Status))
+	}
+	if want := []string{"WARN 1 503", "INFO 2 200"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Log entries = %v, want %v", got, want)
+	}
+}
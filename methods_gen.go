@@ -0,0 +1,1036 @@
+// Code generated by rtmgen from internal/rtmgen/methods.json. DO NOT EDIT.
+
+package rememberthemilk
+
+// apiMethods describes every method of the Remember The Milk API.
+var apiMethods = map[string]apiMethod{
+	"rtm.auth.checkToken":          {},
+	"rtm.auth.getFrob":             {},
+	"rtm.auth.getToken":            {},
+	"rtm.contacts.add":             {permission: PermissionWrite},
+	"rtm.contacts.delete":          {permission: PermissionDelete},
+	"rtm.contacts.getList":         {permission: PermissionRead},
+	"rtm.groups.add":               {permission: PermissionWrite},
+	"rtm.groups.addContact":        {permission: PermissionWrite},
+	"rtm.groups.delete":            {permission: PermissionDelete},
+	"rtm.groups.getList":           {permission: PermissionRead},
+	"rtm.groups.removeContact":     {permission: PermissionWrite},
+	"rtm.lists.add":                {permission: PermissionWrite},
+	"rtm.lists.archive":            {permission: PermissionWrite},
+	"rtm.lists.delete":             {permission: PermissionDelete},
+	"rtm.lists.getList":            {permission: PermissionRead},
+	"rtm.lists.setDefaultList":     {permission: PermissionWrite},
+	"rtm.lists.setName":            {permission: PermissionWrite},
+	"rtm.lists.unarchive":          {permission: PermissionWrite},
+	"rtm.locations.getList":        {permission: PermissionRead},
+	"rtm.reflection.getMethodInfo": {},
+	"rtm.reflection.getMethods":    {},
+	"rtm.settings.getList":         {permission: PermissionRead},
+	"rtm.tags.getList":             {permission: PermissionRead},
+	"rtm.tasks.add":                {permission: PermissionWrite},
+	"rtm.tasks.addTags":            {permission: PermissionWrite},
+	"rtm.tasks.complete":           {permission: PermissionWrite},
+	"rtm.tasks.delete":             {permission: PermissionDelete},
+	"rtm.tasks.getList":            {permission: PermissionRead},
+	"rtm.tasks.movePriority":       {permission: PermissionWrite},
+	"rtm.tasks.moveTo":             {permission: PermissionWrite},
+	"rtm.tasks.notes.add":          {permission: PermissionWrite},
+	"rtm.tasks.notes.delete":       {permission: PermissionDelete},
+	"rtm.tasks.notes.edit":         {permission: PermissionWrite},
+	"rtm.tasks.postpone":           {permission: PermissionWrite},
+	"rtm.tasks.removeTags":         {permission: PermissionWrite},
+	"rtm.tasks.setDueDate":         {permission: PermissionWrite},
+	"rtm.tasks.setEstimate":        {permission: PermissionWrite},
+	"rtm.tasks.setLocation":        {permission: PermissionWrite},
+	"rtm.tasks.setName":            {permission: PermissionWrite},
+	"rtm.tasks.setParentTask":      {permission: PermissionWrite},
+	"rtm.tasks.setPriority":        {permission: PermissionWrite},
+	"rtm.tasks.setRecurrence":      {permission: PermissionWrite},
+	"rtm.tasks.setStartDate":       {permission: PermissionWrite},
+	"rtm.tasks.setTags":            {permission: PermissionWrite},
+	"rtm.tasks.setURL":             {permission: PermissionWrite},
+	"rtm.tasks.uncomplete":         {permission: PermissionWrite},
+	"rtm.test.echo":                {},
+	"rtm.test.login":               {permission: PermissionRead},
+	"rtm.time.convert":             {},
+	"rtm.time.parse":               {},
+	"rtm.timelines.create":         {permission: PermissionRead},
+	"rtm.timezones.getList":        {},
+	"rtm.transactions.undo":        {permission: PermissionWrite},
+}
+
+// AuthCheckTokenParams holds the arguments of rtm.auth.checkToken for Client.Call.
+// If a service provides a method for rtm.auth.checkToken, prefer that one.
+//
+// Returns the credentials attached to an authentication token.
+type AuthCheckTokenParams struct {
+	BaseAPIURLOptions
+}
+
+func (*AuthCheckTokenParams) rtmMethod() string {
+	return "rtm.auth.checkToken"
+}
+
+// AuthGetFrobParams holds the arguments of rtm.auth.getFrob for Client.Call.
+// If a service provides a method for rtm.auth.getFrob, prefer that one.
+//
+// Returns a frob to be used during authentication.
+type AuthGetFrobParams struct {
+	BaseAPIURLOptions
+}
+
+func (*AuthGetFrobParams) rtmMethod() string {
+	return "rtm.auth.getFrob"
+}
+
+// AuthGetTokenParams holds the arguments of rtm.auth.getToken for Client.Call.
+// If a service provides a method for rtm.auth.getToken, prefer that one.
+//
+// Returns the auth token for the given frob, if one has been attached.
+type AuthGetTokenParams struct {
+	// The frob to check.
+	Frob string `url:"frob"`
+
+	BaseAPIURLOptions
+}
+
+func (*AuthGetTokenParams) rtmMethod() string {
+	return "rtm.auth.getToken"
+}
+
+// ContactsAddParams holds the arguments of rtm.contacts.add for Client.Call.
+// If a service provides a method for rtm.contacts.add, prefer that one.
+//
+// Adds a new contact. contact should be a username or email address of a Remember The Milk user.
+type ContactsAddParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The username or email address of the contact to add.
+	Contact string `url:"contact"`
+
+	BaseAPIURLOptions
+}
+
+func (*ContactsAddParams) rtmMethod() string {
+	return "rtm.contacts.add"
+}
+
+// ContactsDeleteParams holds the arguments of rtm.contacts.delete for Client.Call.
+// If a service provides a method for rtm.contacts.delete, prefer that one.
+//
+// Deletes a contact.
+type ContactsDeleteParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the contact to delete.
+	ContactID string `url:"contact_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*ContactsDeleteParams) rtmMethod() string {
+	return "rtm.contacts.delete"
+}
+
+// ContactsGetListParams holds the arguments of rtm.contacts.getList for Client.Call.
+// If a service provides a method for rtm.contacts.getList, prefer that one.
+//
+// Retrieves a list of contacts.
+type ContactsGetListParams struct {
+	BaseAPIURLOptions
+}
+
+func (*ContactsGetListParams) rtmMethod() string {
+	return "rtm.contacts.getList"
+}
+
+// GroupsAddParams holds the arguments of rtm.groups.add for Client.Call.
+// If a service provides a method for rtm.groups.add, prefer that one.
+//
+// Creates a new group.
+type GroupsAddParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The name of the new group.
+	Group string `url:"group"`
+
+	BaseAPIURLOptions
+}
+
+func (*GroupsAddParams) rtmMethod() string {
+	return "rtm.groups.add"
+}
+
+// GroupsAddContactParams holds the arguments of rtm.groups.addContact for Client.Call.
+// If a service provides a method for rtm.groups.addContact, prefer that one.
+//
+// Adds a contact to a group.
+type GroupsAddContactParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the group.
+	GroupID string `url:"group_id"`
+	// The id of the contact to add.
+	ContactID string `url:"contact_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*GroupsAddContactParams) rtmMethod() string {
+	return "rtm.groups.addContact"
+}
+
+// GroupsDeleteParams holds the arguments of rtm.groups.delete for Client.Call.
+// If a service provides a method for rtm.groups.delete, prefer that one.
+//
+// Deletes a group.
+type GroupsDeleteParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the group to delete.
+	GroupID string `url:"group_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*GroupsDeleteParams) rtmMethod() string {
+	return "rtm.groups.delete"
+}
+
+// GroupsGetListParams holds the arguments of rtm.groups.getList for Client.Call.
+// If a service provides a method for rtm.groups.getList, prefer that one.
+//
+// Retrieves a list of groups.
+type GroupsGetListParams struct {
+	BaseAPIURLOptions
+}
+
+func (*GroupsGetListParams) rtmMethod() string {
+	return "rtm.groups.getList"
+}
+
+// GroupsRemoveContactParams holds the arguments of rtm.groups.removeContact for Client.Call.
+// If a service provides a method for rtm.groups.removeContact, prefer that one.
+//
+// Removes a contact from a group.
+type GroupsRemoveContactParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the group.
+	GroupID string `url:"group_id"`
+	// The id of the contact to remove.
+	ContactID string `url:"contact_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*GroupsRemoveContactParams) rtmMethod() string {
+	return "rtm.groups.removeContact"
+}
+
+// ListsAddParams holds the arguments of rtm.lists.add for Client.Call.
+// If a service provides a method for rtm.lists.add, prefer that one.
+//
+// Creates a new list. If filter is provided, a Smart List is created.
+type ListsAddParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The name of the new list.
+	Name string `url:"name"`
+	// The search criteria of a Smart List.
+	Filter string `url:"filter,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*ListsAddParams) rtmMethod() string {
+	return "rtm.lists.add"
+}
+
+// ListsArchiveParams holds the arguments of rtm.lists.archive for Client.Call.
+// If a service provides a method for rtm.lists.archive, prefer that one.
+//
+// Archives a list.
+type ListsArchiveParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list to archive.
+	ListID string `url:"list_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*ListsArchiveParams) rtmMethod() string {
+	return "rtm.lists.archive"
+}
+
+// ListsDeleteParams holds the arguments of rtm.lists.delete for Client.Call.
+// If a service provides a method for rtm.lists.delete, prefer that one.
+//
+// Deletes a list.
+type ListsDeleteParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list to delete.
+	ListID string `url:"list_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*ListsDeleteParams) rtmMethod() string {
+	return "rtm.lists.delete"
+}
+
+// ListsGetListParams holds the arguments of rtm.lists.getList for Client.Call.
+// If a service provides a method for rtm.lists.getList, prefer that one.
+//
+// Retrieves a list of lists.
+type ListsGetListParams struct {
+	BaseAPIURLOptions
+}
+
+func (*ListsGetListParams) rtmMethod() string {
+	return "rtm.lists.getList"
+}
+
+// ListsSetDefaultListParams holds the arguments of rtm.lists.setDefaultList for Client.Call.
+// If a service provides a method for rtm.lists.setDefaultList, prefer that one.
+//
+// Sets the default list.
+type ListsSetDefaultListParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the new default list.
+	ListID string `url:"list_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*ListsSetDefaultListParams) rtmMethod() string {
+	return "rtm.lists.setDefaultList"
+}
+
+// ListsSetNameParams holds the arguments of rtm.lists.setName for Client.Call.
+// If a service provides a method for rtm.lists.setName, prefer that one.
+//
+// Renames a list.
+type ListsSetNameParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list to rename.
+	ListID string `url:"list_id"`
+	// The new name of the list.
+	Name string `url:"name"`
+
+	BaseAPIURLOptions
+}
+
+func (*ListsSetNameParams) rtmMethod() string {
+	return "rtm.lists.setName"
+}
+
+// ListsUnarchiveParams holds the arguments of rtm.lists.unarchive for Client.Call.
+// If a service provides a method for rtm.lists.unarchive, prefer that one.
+//
+// Unarchives a list.
+type ListsUnarchiveParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list to unarchive.
+	ListID string `url:"list_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*ListsUnarchiveParams) rtmMethod() string {
+	return "rtm.lists.unarchive"
+}
+
+// LocationsGetListParams holds the arguments of rtm.locations.getList for Client.Call.
+// If a service provides a method for rtm.locations.getList, prefer that one.
+//
+// Retrieves a list of locations.
+type LocationsGetListParams struct {
+	BaseAPIURLOptions
+}
+
+func (*LocationsGetListParams) rtmMethod() string {
+	return "rtm.locations.getList"
+}
+
+// ReflectionGetMethodInfoParams holds the arguments of rtm.reflection.getMethodInfo for Client.Call.
+// If a service provides a method for rtm.reflection.getMethodInfo, prefer that one.
+//
+// Returns information for a given Remember The Milk API method.
+type ReflectionGetMethodInfoParams struct {
+	// The name of the method.
+	MethodName string `url:"method_name"`
+
+	BaseAPIURLOptions
+}
+
+func (*ReflectionGetMethodInfoParams) rtmMethod() string {
+	return "rtm.reflection.getMethodInfo"
+}
+
+// ReflectionGetMethodsParams holds the arguments of rtm.reflection.getMethods for Client.Call.
+// If a service provides a method for rtm.reflection.getMethods, prefer that one.
+//
+// Returns a list of available Remember The Milk API methods.
+type ReflectionGetMethodsParams struct {
+	BaseAPIURLOptions
+}
+
+func (*ReflectionGetMethodsParams) rtmMethod() string {
+	return "rtm.reflection.getMethods"
+}
+
+// SettingsGetListParams holds the arguments of rtm.settings.getList for Client.Call.
+// If a service provides a method for rtm.settings.getList, prefer that one.
+//
+// Retrieves a list of user settings.
+type SettingsGetListParams struct {
+	BaseAPIURLOptions
+}
+
+func (*SettingsGetListParams) rtmMethod() string {
+	return "rtm.settings.getList"
+}
+
+// TagsGetListParams holds the arguments of rtm.tags.getList for Client.Call.
+// If a service provides a method for rtm.tags.getList, prefer that one.
+//
+// Retrieves a list of tags.
+type TagsGetListParams struct {
+	BaseAPIURLOptions
+}
+
+func (*TagsGetListParams) rtmMethod() string {
+	return "rtm.tags.getList"
+}
+
+// TasksAddParams holds the arguments of rtm.tasks.add for Client.Call.
+// If a service provides a method for rtm.tasks.add, prefer that one.
+//
+// Adds a task, name, to the list specified by list_id. If list_id is omitted, the task will be added to the Inbox.
+type TasksAddParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list to add the task to.
+	ListID string `url:"list_id,omitempty"`
+	// The name of the task.
+	Name string `url:"name"`
+	// Process the name with Smart Add if 1.
+	Parse string `url:"parse,omitempty"`
+	// The id of the parent task.
+	ParentTaskID string `url:"parent_task_id,omitempty"`
+	// An external id of the task.
+	ExternalID string `url:"external_id,omitempty"`
+	// The id of the contact to give the task to.
+	GiveTo string `url:"give_to,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksAddParams) rtmMethod() string {
+	return "rtm.tasks.add"
+}
+
+// TasksAddTagsParams holds the arguments of rtm.tasks.addTags for Client.Call.
+// If a service provides a method for rtm.tasks.addTags, prefer that one.
+//
+// Adds tags to a task. tags should be a comma delimited list of tags.
+type TasksAddTagsParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// A comma delimited list of tags.
+	Tags string `url:"tags"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksAddTagsParams) rtmMethod() string {
+	return "rtm.tasks.addTags"
+}
+
+// TasksCompleteParams holds the arguments of rtm.tasks.complete for Client.Call.
+// If a service provides a method for rtm.tasks.complete, prefer that one.
+//
+// Marks a task complete.
+type TasksCompleteParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksCompleteParams) rtmMethod() string {
+	return "rtm.tasks.complete"
+}
+
+// TasksDeleteParams holds the arguments of rtm.tasks.delete for Client.Call.
+// If a service provides a method for rtm.tasks.delete, prefer that one.
+//
+// Marks a task as deleted.
+type TasksDeleteParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksDeleteParams) rtmMethod() string {
+	return "rtm.tasks.delete"
+}
+
+// TasksGetListParams holds the arguments of rtm.tasks.getList for Client.Call.
+// If a service provides a method for rtm.tasks.getList, prefer that one.
+//
+// Retrieves a list of tasks.
+type TasksGetListParams struct {
+	// The id of the list to retrieve the tasks of.
+	ListID string `url:"list_id,omitempty"`
+	// The search criteria the tasks have to match.
+	Filter string `url:"filter,omitempty"`
+	// Only return tasks modified since this time (ISO 8601).
+	LastSync string `url:"last_sync,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksGetListParams) rtmMethod() string {
+	return "rtm.tasks.getList"
+}
+
+// TasksMovePriorityParams holds the arguments of rtm.tasks.movePriority for Client.Call.
+// If a service provides a method for rtm.tasks.movePriority, prefer that one.
+//
+// Moves the priority of a task up or down.
+type TasksMovePriorityParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// Either up or down.
+	Direction string `url:"direction"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksMovePriorityParams) rtmMethod() string {
+	return "rtm.tasks.movePriority"
+}
+
+// TasksMoveToParams holds the arguments of rtm.tasks.moveTo for Client.Call.
+// If a service provides a method for rtm.tasks.moveTo, prefer that one.
+//
+// Moves a task from one list to another.
+type TasksMoveToParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list the task is in.
+	FromListID string `url:"from_list_id"`
+	// The id of the list to move the task to.
+	ToListID string `url:"to_list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksMoveToParams) rtmMethod() string {
+	return "rtm.tasks.moveTo"
+}
+
+// TasksNotesAddParams holds the arguments of rtm.tasks.notes.add for Client.Call.
+// If a service provides a method for rtm.tasks.notes.add, prefer that one.
+//
+// Adds a new note to a task.
+type TasksNotesAddParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The title of the note.
+	NoteTitle string `url:"note_title"`
+	// The body of the note.
+	NoteText string `url:"note_text"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksNotesAddParams) rtmMethod() string {
+	return "rtm.tasks.notes.add"
+}
+
+// TasksNotesDeleteParams holds the arguments of rtm.tasks.notes.delete for Client.Call.
+// If a service provides a method for rtm.tasks.notes.delete, prefer that one.
+//
+// Deletes a note.
+type TasksNotesDeleteParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the note to delete.
+	NoteID string `url:"note_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksNotesDeleteParams) rtmMethod() string {
+	return "rtm.tasks.notes.delete"
+}
+
+// TasksNotesEditParams holds the arguments of rtm.tasks.notes.edit for Client.Call.
+// If a service provides a method for rtm.tasks.notes.edit, prefer that one.
+//
+// Modifies a note.
+type TasksNotesEditParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the note to edit.
+	NoteID string `url:"note_id"`
+	// The new title of the note.
+	NoteTitle string `url:"note_title"`
+	// The new body of the note.
+	NoteText string `url:"note_text"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksNotesEditParams) rtmMethod() string {
+	return "rtm.tasks.notes.edit"
+}
+
+// TasksPostponeParams holds the arguments of rtm.tasks.postpone for Client.Call.
+// If a service provides a method for rtm.tasks.postpone, prefer that one.
+//
+// Postpones a task.
+type TasksPostponeParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksPostponeParams) rtmMethod() string {
+	return "rtm.tasks.postpone"
+}
+
+// TasksRemoveTagsParams holds the arguments of rtm.tasks.removeTags for Client.Call.
+// If a service provides a method for rtm.tasks.removeTags, prefer that one.
+//
+// Removes tags from a task.
+type TasksRemoveTagsParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// A comma delimited list of tags.
+	Tags string `url:"tags"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksRemoveTagsParams) rtmMethod() string {
+	return "rtm.tasks.removeTags"
+}
+
+// TasksSetDueDateParams holds the arguments of rtm.tasks.setDueDate for Client.Call.
+// If a service provides a method for rtm.tasks.setDueDate, prefer that one.
+//
+// Sets the due date of a task. If due is not provided, the due date is cleared.
+type TasksSetDueDateParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The due date (ISO 8601).
+	Due string `url:"due,omitempty"`
+	// Whether due carries a time of day, 1 or 0.
+	HasDueTime string `url:"has_due_time,omitempty"`
+	// Parse due with the natural language parser if 1.
+	Parse string `url:"parse,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetDueDateParams) rtmMethod() string {
+	return "rtm.tasks.setDueDate"
+}
+
+// TasksSetEstimateParams holds the arguments of rtm.tasks.setEstimate for Client.Call.
+// If a service provides a method for rtm.tasks.setEstimate, prefer that one.
+//
+// Sets the time estimate of a task. If estimate is not provided, the estimate is cleared.
+type TasksSetEstimateParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The time estimate, e.g. 1 hour 30 minutes.
+	Estimate string `url:"estimate,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetEstimateParams) rtmMethod() string {
+	return "rtm.tasks.setEstimate"
+}
+
+// TasksSetLocationParams holds the arguments of rtm.tasks.setLocation for Client.Call.
+// If a service provides a method for rtm.tasks.setLocation, prefer that one.
+//
+// Sets the location of a task. If location_id is not provided, the location is cleared.
+type TasksSetLocationParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The id of the location.
+	LocationID string `url:"location_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetLocationParams) rtmMethod() string {
+	return "rtm.tasks.setLocation"
+}
+
+// TasksSetNameParams holds the arguments of rtm.tasks.setName for Client.Call.
+// If a service provides a method for rtm.tasks.setName, prefer that one.
+//
+// Renames a task.
+type TasksSetNameParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The new name of the task.
+	Name string `url:"name"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetNameParams) rtmMethod() string {
+	return "rtm.tasks.setName"
+}
+
+// TasksSetParentTaskParams holds the arguments of rtm.tasks.setParentTask for Client.Call.
+// If a service provides a method for rtm.tasks.setParentTask, prefer that one.
+//
+// Sets the parent task of a task. If parent_task_id is not provided, the task becomes a top-level task.
+type TasksSetParentTaskParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The id of the parent task.
+	ParentTaskID string `url:"parent_task_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetParentTaskParams) rtmMethod() string {
+	return "rtm.tasks.setParentTask"
+}
+
+// TasksSetPriorityParams holds the arguments of rtm.tasks.setPriority for Client.Call.
+// If a service provides a method for rtm.tasks.setPriority, prefer that one.
+//
+// Sets the priority of a task. If priority is not provided, the priority is cleared.
+type TasksSetPriorityParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The priority, 1, 2, 3 or N.
+	Priority string `url:"priority,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetPriorityParams) rtmMethod() string {
+	return "rtm.tasks.setPriority"
+}
+
+// TasksSetRecurrenceParams holds the arguments of rtm.tasks.setRecurrence for Client.Call.
+// If a service provides a method for rtm.tasks.setRecurrence, prefer that one.
+//
+// Sets the recurrence pattern of a task. If repeat is not provided, the recurrence is cleared.
+type TasksSetRecurrenceParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The recurrence pattern.
+	Repeat string `url:"repeat,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetRecurrenceParams) rtmMethod() string {
+	return "rtm.tasks.setRecurrence"
+}
+
+// TasksSetStartDateParams holds the arguments of rtm.tasks.setStartDate for Client.Call.
+// If a service provides a method for rtm.tasks.setStartDate, prefer that one.
+//
+// Sets the start date of a task. If start is not provided, the start date is cleared.
+type TasksSetStartDateParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The start date (ISO 8601).
+	Start string `url:"start,omitempty"`
+	// Whether start carries a time of day, 1 or 0.
+	HasStartTime string `url:"has_start_time,omitempty"`
+	// Parse start with the natural language parser if 1.
+	Parse string `url:"parse,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetStartDateParams) rtmMethod() string {
+	return "rtm.tasks.setStartDate"
+}
+
+// TasksSetTagsParams holds the arguments of rtm.tasks.setTags for Client.Call.
+// If a service provides a method for rtm.tasks.setTags, prefer that one.
+//
+// Sets the tags of a task. If tags is not provided, all tags are removed.
+type TasksSetTagsParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// A comma delimited list of tags.
+	Tags string `url:"tags,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetTagsParams) rtmMethod() string {
+	return "rtm.tasks.setTags"
+}
+
+// TasksSetURLParams holds the arguments of rtm.tasks.setURL for Client.Call.
+// If a service provides a method for rtm.tasks.setURL, prefer that one.
+//
+// Sets the URL of a task. If url is not provided, the URL is cleared.
+type TasksSetURLParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+	// The URL of the task.
+	URL string `url:"url,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksSetURLParams) rtmMethod() string {
+	return "rtm.tasks.setURL"
+}
+
+// TasksUncompleteParams holds the arguments of rtm.tasks.uncomplete for Client.Call.
+// If a service provides a method for rtm.tasks.uncomplete, prefer that one.
+//
+// Marks a task incomplete.
+type TasksUncompleteParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the list.
+	ListID string `url:"list_id"`
+	// The id of the taskseries.
+	TaskseriesID string `url:"taskseries_id"`
+	// The id of the task.
+	TaskID string `url:"task_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*TasksUncompleteParams) rtmMethod() string {
+	return "rtm.tasks.uncomplete"
+}
+
+// TestEchoParams holds the arguments of rtm.test.echo for Client.Call.
+// If a service provides a method for rtm.test.echo, prefer that one.
+//
+// A testing method which echoes all parameters back in the response.
+type TestEchoParams struct {
+	BaseAPIURLOptions
+}
+
+func (*TestEchoParams) rtmMethod() string {
+	return "rtm.test.echo"
+}
+
+// TestLoginParams holds the arguments of rtm.test.login for Client.Call.
+// If a service provides a method for rtm.test.login, prefer that one.
+//
+// A testing method which checks if the caller is logged in.
+type TestLoginParams struct {
+	BaseAPIURLOptions
+}
+
+func (*TestLoginParams) rtmMethod() string {
+	return "rtm.test.login"
+}
+
+// TimeConvertParams holds the arguments of rtm.time.convert for Client.Call.
+// If a service provides a method for rtm.time.convert, prefer that one.
+//
+// Returns the specified time in the desired timezone.
+type TimeConvertParams struct {
+	// Target timezone.
+	ToTimezone string `url:"to_timezone"`
+	// Originating timezone. Defaults to UTC.
+	FromTimezone string `url:"from_timezone,omitempty"`
+	// Time to convert (ISO 8601). Defaults to now.
+	Time string `url:"time,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TimeConvertParams) rtmMethod() string {
+	return "rtm.time.convert"
+}
+
+// TimeParseParams holds the arguments of rtm.time.parse for Client.Call.
+// If a service provides a method for rtm.time.parse, prefer that one.
+//
+// Returns the specified time as ISO 8601 timestamp, parsed in the user's or the given timezone.
+type TimeParseParams struct {
+	// Text to parse.
+	Text string `url:"text"`
+	// Timezone to parse text in.
+	Timezone string `url:"timezone,omitempty"`
+	// 0 for European format, 1 for American format.
+	Dateformat string `url:"dateformat,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+func (*TimeParseParams) rtmMethod() string {
+	return "rtm.time.parse"
+}
+
+// TimelinesCreateParams holds the arguments of rtm.timelines.create for Client.Call.
+// If a service provides a method for rtm.timelines.create, prefer that one.
+//
+// Returns a new timeline.
+type TimelinesCreateParams struct {
+	BaseAPIURLOptions
+}
+
+func (*TimelinesCreateParams) rtmMethod() string {
+	return "rtm.timelines.create"
+}
+
+// TimezonesGetListParams holds the arguments of rtm.timezones.getList for Client.Call.
+// If a service provides a method for rtm.timezones.getList, prefer that one.
+//
+// Retrieves a list of timezones.
+type TimezonesGetListParams struct {
+	BaseAPIURLOptions
+}
+
+func (*TimezonesGetListParams) rtmMethod() string {
+	return "rtm.timezones.getList"
+}
+
+// TransactionsUndoParams holds the arguments of rtm.transactions.undo for Client.Call.
+// If a service provides a method for rtm.transactions.undo, prefer that one.
+//
+// Reverts the affects of an action.
+type TransactionsUndoParams struct {
+	// Timeline, see rtm.timelines.create.
+	Timeline string `url:"timeline"`
+	// The id of the transaction to undo.
+	TransactionID string `url:"transaction_id"`
+
+	BaseAPIURLOptions
+}
+
+func (*TransactionsUndoParams) rtmMethod() string {
+	return "rtm.transactions.undo"
+}
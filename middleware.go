@@ -0,0 +1,103 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Call is a single call of a Remember The Milk API method passing through the middleware of a Client.
+type Call struct {
+	// Method is the called API method, e.g. rtm.tasks.add.
+	Method string
+
+	// Params are the signed query parameters of the call, including api_sig.
+	// Use SetParam to modify them.
+	Params url.Values
+
+	// Request is the HTTP request sending the call.
+	Request *http.Request
+
+	// Attempt is the number of the current attempt, starting at 1, if the call is retried
+	// according to the RetryPolicy of the Client. It is 0 before the first attempt, i.e.
+	// in middleware added with Client.Use before calling next.
+	Attempt int
+
+	client *Client
+}
+
+// newCall returns the Call of the API request req.
+func newCall(c *Client, req *http.Request) *Call {
+	params := req.URL.Query()
+	return &Call{
+		Method:  params.Get("method"),
+		Params:  params,
+		Request: req,
+		client:  c,
+	}
+}
+
+// SetParam sets the query parameter key to value, signs the parameters again
+// and updates Request accordingly. An empty value removes the parameter.
+//
+// This lets middleware change a call, e.g. replace an expired auth_token.
+func (call *Call) SetParam(key, value string) {
+	if value == "" {
+		call.Params.Del(key)
+	} else {
+		call.Params.Set(key, value)
+	}
+	call.Params.Del("api_sig")
+	call.Params.Set("api_sig", call.client.SignRequest(call.Params))
+	call.Method = call.Params.Get("method")
+
+	u := *call.Request.URL
+	u.RawQuery = call.Params.Encode()
+	call.Request = call.Request.Clone(call.Request.Context())
+	call.Request.URL = &u
+}
+
+// CallHandler sends a Call and returns the API response.
+// On success, it must return a non-nil response whose body has not been read yet.
+// A middleware which does not call next must therefore return either an error or a response.
+type CallHandler func(ctx context.Context, call *Call) (*Response, error)
+
+// Middleware wraps the CallHandler next. It can inspect or modify the call before
+// passing it on, inspect the response and error afterwards, call next several times
+// or not at all.
+type Middleware func(next CallHandler) CallHandler
+
+// Use appends middleware to the middleware chain of the client.
+// The first middleware is the outermost one, i.e. it sees the call first and the response last.
+// The innermost handler checks the permission, waits for the RateLimiter and applies the RetryPolicy,
+// so middleware sees a retried call only once. See UseAttempt to see every attempt.
+//
+// Use must not be called concurrently with API calls.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// UseAttempt appends middleware to the attempt middleware chain of the client.
+// In contrast to Use, attempt middleware wraps every single attempt of a call inside
+// the RetryPolicy, so it sees every retry, e.g. every rate limited attempt, and
+// Call.Attempt tells the attempts apart. It sees the call after the permission check
+// and includes the wait for the RateLimiter.
+//
+// UseAttempt must not be called concurrently with API calls.
+func (c *Client) UseAttempt(middleware ...Middleware) {
+	c.attemptMiddleware = append(c.attemptMiddleware, middleware...)
+}
+
+// chain wraps handler into middleware, the first one being the outermost.
+func chain(middleware []Middleware, handler CallHandler) CallHandler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+	return handler
+}
+
+// errNoResponse reports a middleware which returned neither a response nor an error.
+func errNoResponse(call *Call) error {
+	return fmt.Errorf("middleware returned no response and no error for %s", call.Method)
+}
@@ -0,0 +1,140 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_Use(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.lists.add")
+		testParam(t, r, "auth_token", "refreshed")
+		params := r.URL.Query()
+		signature := params.Get("api_sig")
+		params.Del("api_sig")
+		if want := client.SignRequest(params); signature != want {
+			t.Errorf("Request api_sig: %v, want %v", signature, want)
+		}
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"1","name":"Errands"}}}`)
+	})
+
+	var events []string
+	record := func(name string) Middleware {
+		return func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *Call) (*Response, error) {
+				events = append(events, name+" "+call.Method)
+				resp, err := next(ctx, call)
+				events = append(events, name+" "+resp.Stat+" "+resp.Transaction.ID)
+				return resp, err
+			}
+		}
+	}
+	refresh := func(next CallHandler) CallHandler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			if call.Params.Get("api_sig") == "" {
+				t.Error("Call.Params has no api_sig")
+			}
+			call.SetParam("auth_token", "refreshed")
+			return next(ctx, call)
+		}
+	}
+	client.Use(record("outer"), record("inner"), refresh)
+
+	_, _, err := client.Lists.Add(context.Background(), ListAddInput{Timeline: "1", Name: "Errands"})
+	if err != nil {
+		t.Fatalf("Lists.Add returned error: %v", err)
+	}
+
+	want := []string{
+		"outer rtm.lists.add",
+		"inner rtm.lists.add",
+		"inner ok 4711",
+		"outer ok 4711",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Middleware events = %v, want %v", events, want)
+	}
+}
+
+func TestClient_Use_shortCircuit(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request reached the API")
+	})
+
+	injected := &ErrorResponse{Code: 105, Message: "Service currently unavailable"}
+	client.Use(func(next CallHandler) CallHandler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			return nil, injected
+		}
+	})
+
+	_, _, err := client.Lists.GetList(context.Background())
+	if !errors.Is(err, ErrServiceUnavailable) {
+		t.Errorf("Lists.GetList returned error %v, want %v", err, ErrServiceUnavailable)
+	}
+}
+
+func TestClient_Use_nilResponse(t *testing.T) {
+	client, _ := setup(t)
+
+	client.Use(func(next CallHandler) CallHandler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			return nil, nil
+		}
+	})
+
+	if _, _, err := client.Lists.GetList(context.Background()); err == nil {
+		t.Error("Lists.GetList returned no error")
+	}
+}
+
+func TestClient_UseAttempt(t *testing.T) {
+	client, mux := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	requests := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","lists":{"list":[]}}}`)
+	})
+
+	var calls int
+	client.Use(func(next CallHandler) CallHandler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			calls++
+			return next(ctx, call)
+		}
+	})
+	var attempts []string
+	client.UseAttempt(func(next CallHandler) CallHandler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			resp, err := next(ctx, call)
+			attempts = append(attempts, fmt.Sprintf("%d %v", call.Attempt, errors.Is(err, ErrRateLimited)))
+			return resp, err
+		}
+	})
+
+	if _, _, err := client.Lists.GetList(context.Background()); err != nil {
+		t.Fatalf("Lists.GetList returned error: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("Call middleware ran %d times, want 1", calls)
+	}
+	if want := []string{"1 true", "2 false"}; !reflect.DeepEqual(attempts, want) {
+		t.Errorf("Attempt middleware saw %v, want %v", attempts, want)
+	}
+}
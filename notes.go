@@ -0,0 +1,84 @@
+package rememberthemilk
+
+import (
+	"context"
+)
+
+// NotesService handles communication with the note related
+// methods of the Remember The Milk API.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods.rtm
+type NotesService service
+
+// Note represents a note attached to a taskseries.
+type Note struct {
+	ID       string `json:"id"`
+	Created  string `json:"created"`
+	Modified string `json:"modified"`
+	Title    string `json:"title"`
+	Body     string `json:"$t"`
+}
+
+type NoteAddInput struct {
+	Timeline     string `url:"timeline,omitempty"`
+	ListID       string `url:"list_id,omitempty"`
+	TaskseriesID string `url:"taskseries_id,omitempty"`
+	TaskID       string `url:"task_id,omitempty"`
+	Title        string `url:"note_title"`
+	Text         string `url:"note_text"`
+
+	BaseAPIURLOptions
+}
+
+type NoteEditInput struct {
+	Timeline string `url:"timeline,omitempty"`
+	NoteID   string `url:"note_id,omitempty"`
+	Title    string `url:"note_title"`
+	Text     string `url:"note_text"`
+
+	BaseAPIURLOptions
+}
+
+type NoteDeleteInput struct {
+	Timeline string `url:"timeline,omitempty"`
+	NoteID   string `url:"note_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+type NoteModifyResponse struct {
+	Transaction Transaction `json:"transaction"`
+	Note        Note        `json:"note"`
+
+	BaseResponse
+}
+
+// Add adds a new note to the task identified by NoteAddInput.ListID, NoteAddInput.TaskseriesID and NoteAddInput.TaskID.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.notes.add.rtm
+func (s *NotesService) Add(ctx context.Context, note NoteAddInput) (*NoteModifyResponse, *Response, error) {
+	note.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.notes.add")
+	return doAPI[NoteModifyResponse](ctx, s.client, note)
+}
+
+// Edit modifies the title and text of a note.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.notes.edit.rtm
+func (s *NotesService) Edit(ctx context.Context, note NoteEditInput) (*NoteModifyResponse, *Response, error) {
+	note.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.notes.edit")
+	return doAPI[NoteModifyResponse](ctx, s.client, note)
+}
+
+// Delete deletes a note. The returned NoteModifyResponse only carries the transaction.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.notes.delete.rtm
+func (s *NotesService) Delete(ctx context.Context, note NoteDeleteInput) (*NoteModifyResponse, *Response, error) {
+	note.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.notes.delete")
+	return doAPI[NoteModifyResponse](ctx, s.client, note)
+}
@@ -0,0 +1,99 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNotesService_Add(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.notes.add")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "taskseries_id", "117192")
+		testParam(t, r, "note_title", "Build log")
+		testParam(t, r, "note_text", "exit status 1")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"0"},
+			"note":{"id":"169624","created":"2025-01-01T10:00:00Z","modified":"2025-01-01T10:00:00Z","title":"Build log","$t":"exit status 1"}}}`)
+	})
+
+	input := NoteAddInput{
+		Timeline:     "12741021",
+		ListID:       "100653",
+		TaskseriesID: "117192",
+		TaskID:       "216859",
+		Title:        "Build log",
+		Text:         "exit status 1",
+	}
+	result, _, err := client.Notes.Add(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Notes.Add returned error: %v", err)
+	}
+
+	want := Note{ID: "169624", Created: "2025-01-01T10:00:00Z", Modified: "2025-01-01T10:00:00Z", Title: "Build log", Body: "exit status 1"}
+	if result.Note != want {
+		t.Errorf("Notes.Add returned %+v, want %+v", result.Note, want)
+	}
+}
+
+func TestNotesService_Edit(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.notes.edit")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "note_id", "169624")
+		testParam(t, r, "note_title", "Build log")
+		// An empty text is sent as well, as it clears the body of the note.
+		if q := r.URL.Query(); !q.Has("note_text") || q.Get("note_text") != "" {
+			t.Errorf("Request note_text = %q, want an empty note_text", q["note_text"])
+		}
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4712","undoable":"0"},
+			"note":{"id":"169624","created":"2025-01-01T10:00:00Z","modified":"2025-01-02T10:00:00Z","title":"Build log","$t":""}}}`)
+	})
+
+	input := NoteEditInput{
+		Timeline: "12741021",
+		NoteID:   "169624",
+		Title:    "Build log",
+	}
+	result, _, err := client.Notes.Edit(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Notes.Edit returned error: %v", err)
+	}
+
+	want := Note{ID: "169624", Created: "2025-01-01T10:00:00Z", Modified: "2025-01-02T10:00:00Z", Title: "Build log"}
+	if result.Note != want {
+		t.Errorf("Notes.Edit returned %+v, want %+v", result.Note, want)
+	}
+}
+
+func TestNotesService_Delete(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.notes.delete")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "note_id", "169624")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4713","undoable":"0"}}}`)
+	})
+
+	input := NoteDeleteInput{
+		Timeline: "12741021",
+		NoteID:   "169624",
+	}
+	result, _, err := client.Notes.Delete(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Notes.Delete returned error: %v", err)
+	}
+
+	if result.Transaction.ID != "4713" {
+		t.Errorf("Notes.Delete returned transaction %q, want %q", result.Transaction.ID, "4713")
+	}
+	if result.Note != (Note{}) {
+		t.Errorf("Notes.Delete returned note %+v, want none", result.Note)
+	}
+}
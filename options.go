@@ -0,0 +1,188 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ClientOption configures a Client created by NewClientWithOptions.
+type ClientOption func(c *Client) error
+
+// NewClientWithOptions returns a new Remember the Milk API client configured by opts.
+// Options are applied in order. The API key and shared secret are required, see WithCredentials.
+//
+// Unless configured otherwise, the client has the same defaults as one returned by NewClient.
+func NewClientWithOptions(opts ...ClientOption) (*Client, error) {
+	c := NewClient("", "", "", nil)
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.apiKey == "" || c.sharedSecret == "" {
+		return nil, errors.New("API key and shared secret are required, see WithCredentials")
+	}
+	return c, nil
+}
+
+// WithCredentials sets the API key and shared secret of the application.
+func WithCredentials(apiKey, sharedSecret string) ClientOption {
+	return func(c *Client) error {
+		if apiKey == "" || sharedSecret == "" {
+			return errors.New("API key and shared secret must not be empty")
+		}
+		c.apiKey = apiKey
+		c.sharedSecret = sharedSecret
+		return nil
+	}
+}
+
+// WithAuthenticationToken sets the authentication token. See Client.SetAuthenticationToken.
+func WithAuthenticationToken(token string) ClientOption {
+	return func(c *Client) error {
+		c.SetAuthenticationToken(token)
+		return nil
+	}
+}
+
+// WithTokenStore loads the authentication token from store and keeps it in sync.
+// See Client.UseTokenStore.
+func WithTokenStore(store TokenStore) ClientOption {
+	return func(c *Client) error {
+		if store == nil {
+			return errors.New("token store must not be nil")
+		}
+		return c.UseTokenStore(context.Background(), store)
+	}
+}
+
+// WithBaseURL sets the base URL for API requests. It must be an absolute
+// http or https URL with a trailing slash.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := parseBaseURL(baseURL)
+		if err != nil {
+			return fmt.Errorf("base URL: %w", err)
+		}
+		c.BaseURL = u
+		return nil
+	}
+}
+
+// WithWebBaseURL sets the web base URL for authentication requests. It must be
+// an absolute http or https URL with a trailing slash.
+func WithWebBaseURL(webBaseURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := parseBaseURL(webBaseURL)
+		if err != nil {
+			return fmt.Errorf("web base URL: %w", err)
+		}
+		c.WebBaseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent sets the user agent used when communicating with the API.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		if userAgent == "" {
+			return errors.New("user agent must not be empty")
+		}
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to communicate with the API.
+// Like NewClient, the client uses a copy of httpClient.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("HTTP client must not be nil")
+		}
+		httpClient2 := *httpClient
+		c.client = &httpClient2
+		return nil
+	}
+}
+
+// WithRateLimiter sets the rate limiter of the client. A nil limiter disables rate limiting.
+// See Client.RateLimiter.
+func WithRateLimiter(limiter Limiter) ClientOption {
+	return func(c *Client) error {
+		c.RateLimiter = limiter
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the client. A nil policy disables retries.
+// See Client.RetryPolicy.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if policy != nil {
+			if policy.MaxAttempts < 1 {
+				return fmt.Errorf("retry policy: max attempts must be at least 1, got %d", policy.MaxAttempts)
+			}
+			if policy.BaseDelay < 0 || policy.MaxDelay < 0 {
+				return errors.New("retry policy: delays must not be negative")
+			}
+			if policy.MaxDelay > 0 && policy.BaseDelay > policy.MaxDelay {
+				return fmt.Errorf("retry policy: base delay %v exceeds max delay %v", policy.BaseDelay, policy.MaxDelay)
+			}
+		}
+		c.RetryPolicy = policy
+		return nil
+	}
+}
+
+// WithLogger sets the logger of the client. See Client.Logger.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithPermissionCheck enables or disables the local permission check. It is enabled by default.
+// See Client.DisablePermissionCheck.
+func WithPermissionCheck(enabled bool) ClientOption {
+	return func(c *Client) error {
+		c.DisablePermissionCheck = !enabled
+		return nil
+	}
+}
+
+// WithAPIVersion sets the version of the Remember The Milk API. Defaults to version 2.
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) error {
+		if version == "" {
+			return errors.New("API version must not be empty")
+		}
+		c.apiVersion = version
+		return nil
+	}
+}
+
+// parseBaseURL parses rawURL and checks that it is suitable as Client.BaseURL or Client.WebBaseURL.
+func parseBaseURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("%q must be an http or https URL", rawURL)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("%q has no host", rawURL)
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		return nil, fmt.Errorf("%q must have a trailing slash", rawURL)
+	}
+	return u, nil
+}
@@ -0,0 +1,143 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Minute}
+	policy := NewRetryPolicy(3)
+	logger := slog.New(slog.DiscardHandler)
+	store := &MemoryTokenStore{}
+	if err := store.Save(context.Background(), &Authentication{Token: "stored", Permissions: PermissionWrite}); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewClientWithOptions(
+		WithCredentials("api-key", "shared-secret"),
+		WithBaseURL("http://127.0.0.1:8080/rest/"),
+		WithWebBaseURL("http://127.0.0.1:8080/web/"),
+		WithUserAgent("sync/1.0"),
+		WithHTTPClient(httpClient),
+		WithRateLimiter(nil),
+		WithRetryPolicy(policy),
+		WithTokenStore(store),
+		WithAPIVersion("3"),
+		WithLogger(logger),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if got, want := client.BaseURL.String(), "http://127.0.0.1:8080/rest/"; got != want {
+		t.Errorf("BaseURL = %v, want %v", got, want)
+	}
+	if got, want := client.WebBaseURL.String(), "http://127.0.0.1:8080/web/"; got != want {
+		t.Errorf("WebBaseURL = %v, want %v", got, want)
+	}
+	if got, want := client.UserAgent, "sync/1.0"; got != want {
+		t.Errorf("UserAgent = %v, want %v", got, want)
+	}
+	if client.client == httpClient || client.client.Timeout != time.Minute {
+		t.Errorf("HTTP client = %v, want a copy of %v", client.client, httpClient)
+	}
+	if client.RateLimiter != nil {
+		t.Errorf("RateLimiter = %v, want nil", client.RateLimiter)
+	}
+	if client.RetryPolicy != policy {
+		t.Errorf("RetryPolicy = %v, want %v", client.RetryPolicy, policy)
+	}
+	if client.Logger != logger {
+		t.Errorf("Logger = %v, want %v", client.Logger, logger)
+	}
+	if got, want := client.authentication(), "stored"; got != want {
+		t.Errorf("authentication token = %v, want %v", got, want)
+	}
+	if got, want := client.addBaseAPIURLOptions("rtm.test.echo").Version, "3"; got != want {
+		t.Errorf("API version = %v, want %v", got, want)
+	}
+}
+
+func TestNewClientWithOptions_defaults(t *testing.T) {
+	client, err := NewClientWithOptions(WithCredentials("api-key", "shared-secret"), WithAuthenticationToken("token"))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if got, want := client.BaseURL.String(), defaultBaseURL; got != want {
+		t.Errorf("BaseURL = %v, want %v", got, want)
+	}
+	if client.RateLimiter == nil {
+		t.Error("RateLimiter = nil, want default rate limiter")
+	}
+	if got, want := client.addBaseAPIURLOptions("rtm.test.echo"), (BaseAPIURLOptions{
+		Method:              "rtm.test.echo",
+		APIKey:              "api-key",
+		AuthenticationToken: "token",
+		Format:              ResponseFormatJSON,
+		Version:             defaultAPIVersion,
+	}); got != want {
+		t.Errorf("base options = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewClientWithOptions_invalid(t *testing.T) {
+	credentials := WithCredentials("api-key", "shared-secret")
+	tests := []struct {
+		name string
+		opts []ClientOption
+	}{
+		{name: "missing credentials", opts: nil},
+		{name: "empty shared secret", opts: []ClientOption{WithCredentials("api-key", "")}},
+		{name: "base URL without trailing slash", opts: []ClientOption{credentials, WithBaseURL("https://api.example.com/rest")}},
+		{name: "relative base URL", opts: []ClientOption{credentials, WithBaseURL("rest/")}},
+		{name: "web base URL with unsupported scheme", opts: []ClientOption{credentials, WithWebBaseURL("ftp://example.com/")}},
+		{name: "empty user agent", opts: []ClientOption{credentials, WithUserAgent("")}},
+		{name: "nil HTTP client", opts: []ClientOption{credentials, WithHTTPClient(nil)}},
+		{name: "retry policy without attempts", opts: []ClientOption{credentials, WithRetryPolicy(&RetryPolicy{})}},
+		{name: "retry policy with base delay above max delay", opts: []ClientOption{credentials, WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Second})}},
+		{name: "nil token store", opts: []ClientOption{credentials, WithTokenStore(nil)}},
+		{name: "empty API version", opts: []ClientOption{credentials, WithAPIVersion("")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewClientWithOptions(tt.opts...); err == nil {
+				t.Error("NewClientWithOptions returned no error")
+			}
+		})
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("User-Agent"), "sync/1.0"; got != want {
+			t.Errorf("Request User-Agent: %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","lists":{"list":[]}}}`)
+	})
+
+	client, err := NewClientWithOptions(
+		WithCredentials("api-key", "shared-secret"),
+		WithBaseURL(server.URL+"/"),
+		WithRateLimiter(nil),
+		WithUserAgent("sync/1.0"),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if _, _, err := client.Lists.GetList(context.Background()); err != nil {
+		t.Fatalf("Lists.GetList returned error: %v", err)
+	}
+}
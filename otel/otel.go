@@ -0,0 +1,256 @@
+// Package otel instruments a Remember The Milk API client with OpenTelemetry tracing and metrics.
+//
+// Every API call produces a client span named after the API method, e.g. rtm.tasks.add:
+//
+//	client := rememberthemilk.NewClient(apiKey, sharedSecret, token, nil)
+//	if err := otel.Instrument(client); err != nil {
+//		// handle error
+//	}
+//
+// By default, the global TracerProvider and MeterProvider are used.
+//
+// The package is a separate module, so users of the client do not depend on OpenTelemetry:
+//
+//	go get github.com/andygrunwald/go-rememberthemilk/otel
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	globalotel "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/andygrunwald/go-rememberthemilk"
+)
+
+// ScopeName is the instrumentation scope name of the tracer and meter.
+const ScopeName = "github.com/andygrunwald/go-rememberthemilk/otel"
+
+// Attribute keys set on spans and metrics.
+const (
+	// MethodKey is the called API method, e.g. rtm.tasks.add.
+	MethodKey = attribute.Key("rtm.method")
+	// ListIDKey is the list_id parameter of the call.
+	ListIDKey = attribute.Key("rtm.list_id")
+	// TimelineKey is the timeline parameter of the call.
+	TimelineKey = attribute.Key("rtm.timeline")
+	// TransactionIDKey is the ID of the transaction of a write call.
+	TransactionIDKey = attribute.Key("rtm.transaction_id")
+	// StatKey is the status of the API response, ok or fail.
+	StatKey = attribute.Key("rtm.stat")
+	// ErrorCodeKey is the error code of a failed call, see rememberthemilk.ErrorResponse.
+	ErrorCodeKey = attribute.Key("rtm.error_code")
+	// AttemptKey is the number of an attempt of a call retried according to the client's RetryPolicy.
+	AttemptKey = attribute.Key("rtm.attempt")
+	// HTTPStatusCodeKey is the HTTP status code of the API response.
+	HTTPStatusCodeKey = attribute.Key("http.response.status_code")
+)
+
+// Option configures Instrument.
+type Option func(c *config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the TracerProvider. Defaults to the global TracerProvider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the MeterProvider. Defaults to the global MeterProvider.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// instruments holds the tracer and metric instruments of an instrumented client.
+type instruments struct {
+	tracer trace.Tracer
+
+	duration    metric.Float64Histogram
+	failures    metric.Int64Counter
+	rateLimited metric.Int64Counter
+	limiterWait metric.Float64Histogram
+}
+
+// Instrument adds a middleware to client which traces every API call and records
+// the following metrics:
+//
+//   - rtm.client.call.duration: latency of API calls in seconds
+//   - rtm.client.call.failures: number of failed API calls
+//   - rtm.client.rate_limited: number of attempts rejected with rememberthemilk.ErrRateLimited
+//   - rtm.client.rate_limiter.wait: time spent waiting for the client's rate limiter in seconds
+//
+// The duration of a call includes retries according to the client's RetryPolicy.
+// Every failed attempt, including those retried successfully, is added as event
+// rtm.attempt.failed to the span and counted in rtm.client.rate_limited if it was rate limited.
+// Instrument wraps the client's RateLimiter, so set it before calling Instrument.
+func Instrument(client *rememberthemilk.Client, opts ...Option) error {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.tracerProvider == nil {
+		cfg.tracerProvider = globalotel.GetTracerProvider()
+	}
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = globalotel.GetMeterProvider()
+	}
+
+	inst, err := newInstruments(cfg)
+	if err != nil {
+		return err
+	}
+
+	if client.RateLimiter != nil {
+		client.RateLimiter = &limiter{next: client.RateLimiter, wait: inst.limiterWait}
+	}
+	client.Use(inst.middleware)
+	client.UseAttempt(inst.attemptMiddleware)
+	return nil
+}
+
+func newInstruments(cfg *config) (*instruments, error) {
+	meter := cfg.meterProvider.Meter(ScopeName)
+	inst := &instruments{
+		tracer: cfg.tracerProvider.Tracer(ScopeName),
+	}
+
+	var err, e error
+	inst.duration, e = meter.Float64Histogram("rtm.client.call.duration",
+		metric.WithDescription("Latency of Remember The Milk API calls."),
+		metric.WithUnit("s"))
+	err = errors.Join(err, e)
+	inst.failures, e = meter.Int64Counter("rtm.client.call.failures",
+		metric.WithDescription("Number of failed Remember The Milk API calls."),
+		metric.WithUnit("{call}"))
+	err = errors.Join(err, e)
+	inst.rateLimited, e = meter.Int64Counter("rtm.client.rate_limited",
+		metric.WithDescription("Number of Remember The Milk API attempts rejected because the rate limit was exceeded."),
+		metric.WithUnit("{attempt}"))
+	err = errors.Join(err, e)
+	inst.limiterWait, e = meter.Float64Histogram("rtm.client.rate_limiter.wait",
+		metric.WithDescription("Time spent waiting for the client-side rate limiter."),
+		metric.WithUnit("s"))
+	err = errors.Join(err, e)
+
+	if err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+// middleware traces a call and records its metrics.
+func (inst *instruments) middleware(next rememberthemilk.CallHandler) rememberthemilk.CallHandler {
+	return func(ctx context.Context, call *rememberthemilk.Call) (*rememberthemilk.Response, error) {
+		attrs := []attribute.KeyValue{MethodKey.String(call.Method)}
+		if listID := call.Params.Get("list_id"); listID != "" {
+			attrs = append(attrs, ListIDKey.String(listID))
+		}
+		if timeline := call.Params.Get("timeline"); timeline != "" {
+			attrs = append(attrs, TimelineKey.String(timeline))
+		}
+
+		ctx, span := inst.tracer.Start(ctx, call.Method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...))
+		defer span.End()
+
+		start := time.Now()
+		resp, err := next(ctx, call)
+		elapsed := time.Since(start)
+
+		metricAttrs := []attribute.KeyValue{MethodKey.String(call.Method)}
+		if resp != nil {
+			if resp.Response != nil {
+				span.SetAttributes(HTTPStatusCodeKey.Int(resp.StatusCode))
+			}
+			if resp.Stat != "" {
+				span.SetAttributes(StatKey.String(resp.Stat))
+				metricAttrs = append(metricAttrs, StatKey.String(resp.Stat))
+			}
+			if resp.Transaction != nil {
+				span.SetAttributes(TransactionIDKey.String(resp.Transaction.ID))
+			}
+		}
+
+		if err != nil {
+			var errorResponse *rememberthemilk.ErrorResponse
+			if errors.As(err, &errorResponse) {
+				code := ErrorCodeKey.String(strconv.Itoa(errorResponse.Code))
+				span.SetAttributes(code)
+				metricAttrs = append(metricAttrs, code)
+			}
+			recorded := withoutURL(err)
+			span.RecordError(recorded)
+			span.SetStatus(codes.Error, recorded.Error())
+
+			inst.failures.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+		}
+
+		inst.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(metricAttrs...))
+		return resp, err
+	}
+}
+
+// withoutURL strips the request URL from transport errors, because its query contains credentials.
+func withoutURL(err error) error {
+	var urlError *url.Error
+	if errors.As(err, &urlError) {
+		return fmt.Errorf("%s: %w", urlError.Op, urlError.Err)
+	}
+	return err
+}
+
+// attemptMiddleware records every attempt of a call. Failed attempts are added as events
+// to the span of the call, rate limited attempts are counted, even if a retry succeeds.
+func (inst *instruments) attemptMiddleware(next rememberthemilk.CallHandler) rememberthemilk.CallHandler {
+	return func(ctx context.Context, call *rememberthemilk.Call) (*rememberthemilk.Response, error) {
+		resp, err := next(ctx, call)
+		if err == nil {
+			return resp, nil
+		}
+
+		if errors.Is(err, rememberthemilk.ErrRateLimited) {
+			inst.rateLimited.Add(ctx, 1, metric.WithAttributes(MethodKey.String(call.Method)))
+		}
+
+		attrs := []attribute.KeyValue{
+			AttemptKey.Int(call.Attempt),
+			attribute.String("error.message", withoutURL(err).Error()),
+		}
+		var errorResponse *rememberthemilk.ErrorResponse
+		if errors.As(err, &errorResponse) {
+			attrs = append(attrs, ErrorCodeKey.String(strconv.Itoa(errorResponse.Code)))
+		}
+		trace.SpanFromContext(ctx).AddEvent("rtm.attempt.failed", trace.WithAttributes(attrs...))
+		return resp, err
+	}
+}
+
+// limiter records the time spent waiting for the wrapped Limiter.
+type limiter struct {
+	next rememberthemilk.Limiter
+	wait metric.Float64Histogram
+}
+
+// Wait implements the rememberthemilk.Limiter interface.
+func (l *limiter) Wait(ctx context.Context) error {
+	start := time.Now()
+	err := l.next.Wait(ctx)
+	l.wait.Record(ctx, time.Since(start).Seconds())
+	return err
+}
@@ -0,0 +1,212 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/andygrunwald/go-rememberthemilk"
+)
+
+// setup returns an instrumented client talking to handler, along with the span recorder and metric reader.
+func setup(t *testing.T, handler http.HandlerFunc) (*rememberthemilk.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client, err := rememberthemilk.NewClientWithOptions(
+		rememberthemilk.WithCredentials("api-key", "shared-secret"),
+		rememberthemilk.WithAuthenticationToken("token"),
+		rememberthemilk.WithBaseURL(server.URL+"/"),
+		rememberthemilk.WithRateLimiter(rememberthemilk.NewRateLimiter(0, 1)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Instrument(client,
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatalf("Instrument returned error: %v", err)
+	}
+	return client, recorder, reader
+}
+
+func TestInstrument_span(t *testing.T) {
+	client, recorder, reader := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"42","taskseries":[]}}}`)
+	})
+
+	_, _, err := client.Tasks.Add(context.Background(), rememberthemilk.TaskInput{Timeline: "1", ListID: "42", Name: "Buy milk"})
+	if err != nil {
+		t.Fatalf("Tasks.Add returned error: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Recorded %d spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if got, want := span.Name(), "rtm.tasks.add"; got != want {
+		t.Errorf("Span name = %v, want %v", got, want)
+	}
+	if got, want := span.SpanKind(), trace.SpanKindClient; got != want {
+		t.Errorf("Span kind = %v, want %v", got, want)
+	}
+	testAttributes(t, span.Attributes(),
+		MethodKey.String("rtm.tasks.add"),
+		ListIDKey.String("42"),
+		TimelineKey.String("1"),
+		TransactionIDKey.String("4711"),
+		StatKey.String("ok"),
+		HTTPStatusCodeKey.Int(http.StatusOK),
+	)
+
+	metrics := collect(t, reader)
+	if got := histogramCount(metrics["rtm.client.call.duration"]); got != 1 {
+		t.Errorf("rtm.client.call.duration count = %d, want 1", got)
+	}
+	if got := histogramCount(metrics["rtm.client.rate_limiter.wait"]); got != 1 {
+		t.Errorf("rtm.client.rate_limiter.wait count = %d, want 1", got)
+	}
+	if _, ok := metrics["rtm.client.call.failures"]; ok {
+		t.Error("rtm.client.call.failures recorded for a successful call")
+	}
+}
+
+func TestInstrument_failure(t *testing.T) {
+	client, recorder, reader := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Lists.GetList(context.Background())
+	if !errors.Is(err, rememberthemilk.ErrRateLimited) {
+		t.Fatalf("Lists.GetList returned error %v, want %v", err, rememberthemilk.ErrRateLimited)
+	}
+
+	span := recorder.Ended()[0]
+	if got := span.Status().Code; got != codes.Error {
+		t.Errorf("Span status = %v, want %v", got, codes.Error)
+	}
+	testAttributes(t, span.Attributes(), ErrorCodeKey.String("503"), HTTPStatusCodeKey.Int(http.StatusServiceUnavailable))
+
+	metrics := collect(t, reader)
+	if got := sum(metrics["rtm.client.call.failures"]); got != 1 {
+		t.Errorf("rtm.client.call.failures = %d, want 1", got)
+	}
+	if got := sum(metrics["rtm.client.rate_limited"]); got != 1 {
+		t.Errorf("rtm.client.rate_limited = %d, want 1", got)
+	}
+}
+
+func TestWithoutURL(t *testing.T) {
+	err := fmt.Errorf("calling: %w", &url.Error{
+		Op:  "Get",
+		URL: "https://api.rememberthemilk.com/services/rest/?api_key=key&auth_token=token",
+		Err: errors.New("connection refused"),
+	})
+
+	got := withoutURL(err).Error()
+	if strings.Contains(got, "token") || got != "Get: connection refused" {
+		t.Errorf("withoutURL() = %v, want %v", got, "Get: connection refused")
+	}
+}
+
+func testAttributes(t *testing.T, got []attribute.KeyValue, want ...attribute.KeyValue) {
+	t.Helper()
+	set := attribute.NewSet(got...)
+	for _, kv := range want {
+		if v, ok := set.Value(kv.Key); !ok || v != kv.Value {
+			t.Errorf("Attribute %s = %v, want %v", kv.Key, v.Emit(), kv.Value.Emit())
+		}
+	}
+}
+
+// collect returns the collected metrics by name.
+func collect(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	return metrics
+}
+
+func histogramCount(data metricdata.Aggregation) uint64 {
+	histogram, _ := data.(metricdata.Histogram[float64])
+	var count uint64
+	for _, dp := range histogram.DataPoints {
+		count += dp.Count
+	}
+	return count
+}
+
+func sum(data metricdata.Aggregation) int64 {
+	s, _ := data.(metricdata.Sum[int64])
+	var total int64
+	for _, dp := range s.DataPoints {
+		total += dp.Value
+	}
+	return total
+}
+
+func TestInstrument_retriedRateLimit(t *testing.T) {
+	requests := 0
+	client, recorder, reader := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","lists":{"list":[]}}}`)
+	})
+	client.RetryPolicy = &rememberthemilk.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	if _, _, err := client.Lists.GetList(context.Background()); err != nil {
+		t.Fatalf("Lists.GetList returned error: %v", err)
+	}
+
+	span := recorder.Ended()[0]
+	if got := span.Status().Code; got == codes.Error {
+		t.Errorf("Span status = %v, want no error", got)
+	}
+	events := span.Events()
+	if len(events) != 1 || events[0].Name != "rtm.attempt.failed" {
+		t.Fatalf("Span events = %+v, want one rtm.attempt.failed", events)
+	}
+	testAttributes(t, events[0].Attributes, AttemptKey.Int(1), ErrorCodeKey.String("503"))
+
+	metrics := collect(t, reader)
+	if got := sum(metrics["rtm.client.rate_limited"]); got != 1 {
+		t.Errorf("rtm.client.rate_limited = %d, want 1", got)
+	}
+	if got := sum(metrics["rtm.client.call.failures"]); got != 0 {
+		t.Errorf("rtm.client.call.failures = %d, want 0", got)
+	}
+}
@@ -0,0 +1,76 @@
+package rememberthemilk
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInsufficientPermission is returned if an API method requires a higher
+// permission than the authentication token of the client grants.
+// Use errors.Is to check for it, the concrete error is an *InsufficientPermissionError.
+var ErrInsufficientPermission = errors.New("insufficient permission")
+
+// InsufficientPermissionError reports an API call which was rejected locally,
+// because it requires a higher permission than the authentication token grants.
+type InsufficientPermissionError struct {
+	// Method is the API method, e.g. "rtm.tasks.delete".
+	Method string
+
+	// Required is the permission the method requires. See constants Permission*.
+	Required string
+
+	// Granted is the permission the authentication token grants. See constants Permission*.
+	Granted string
+}
+
+func (e *InsufficientPermissionError) Error() string {
+	return fmt.Sprintf("%s requires %s permission, but the token only grants %s permission", e.Method, e.Required, e.Granted)
+}
+
+// Is makes errors.Is(err, ErrInsufficientPermission) report true.
+func (e *InsufficientPermissionError) Is(target error) bool {
+	return target == ErrInsufficientPermission
+}
+
+// apiMethod describes a method of the Remember The Milk API.
+// The descriptions are generated into apiMethods, see methods_gen.go.
+type apiMethod struct {
+	// permission is the permission the method requires. Empty if none is required.
+	permission string
+}
+
+// permissionLevels orders the permissions, each level includes the ones below.
+var permissionLevels = map[string]int{
+	PermissionRead:   1,
+	PermissionWrite:  2,
+	PermissionDelete: 3,
+}
+
+// permits reports whether the permission granted includes the permission required.
+func permits(granted, required string) bool {
+	return permissionLevels[granted] >= permissionLevels[required]
+}
+
+// checkPermission returns an *InsufficientPermissionError if the permission
+// of the authentication token is known and does not cover method.
+// It always returns nil if the client has DisablePermissionCheck set.
+func (c *Client) checkPermission(method string) error {
+	if c.DisablePermissionCheck {
+		return nil
+	}
+
+	granted := c.Permissions()
+	if granted == "" {
+		return nil
+	}
+
+	required := apiMethods[method].permission
+	if permits(granted, required) {
+		return nil
+	}
+	return &InsufficientPermissionError{
+		Method:   method,
+		Required: required,
+		Granted:  granted,
+	}
+}
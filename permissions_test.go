@@ -0,0 +1,62 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_checkPermission(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("method") {
+		case "rtm.auth.checkToken":
+			fmt.Fprint(w, `{"rsp":{"stat":"ok","auth":{"token":"token","perms":"write","user":{"id":"1","username":"bob"}}}}`)
+		case "rtm.tasks.complete":
+			fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"1"}}}`)
+		default:
+			t.Errorf("Unexpected call of %q", r.URL.Query().Get("method"))
+		}
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Authentication.CheckToken(ctx); err != nil {
+		t.Fatalf("Authentication.CheckToken returned error: %v", err)
+	}
+	if got := client.Permissions(); got != PermissionWrite {
+		t.Fatalf("Permissions = %q, want %q", got, PermissionWrite)
+	}
+
+	if _, _, err := client.Tasks.Complete(ctx, TaskModifyInput{Timeline: "1"}); err != nil {
+		t.Errorf("Tasks.Complete returned error: %v", err)
+	}
+
+	_, _, err := client.Tasks.Delete(ctx, TaskModifyInput{Timeline: "1"})
+	if !errors.Is(err, ErrInsufficientPermission) {
+		t.Fatalf("Tasks.Delete returned error %v, want %v", err, ErrInsufficientPermission)
+	}
+	var permErr *InsufficientPermissionError
+	if !errors.As(err, &permErr) || permErr.Required != PermissionDelete || permErr.Granted != PermissionWrite {
+		t.Errorf("Tasks.Delete returned %+v, want required %q and granted %q", permErr, PermissionDelete, PermissionWrite)
+	}
+
+	client.SetAuthenticationToken("other")
+	if got := client.Permissions(); got != "" {
+		t.Errorf("Permissions = %q after changing the token, want none", got)
+	}
+}
+
+func TestClient_checkPermission_disabled(t *testing.T) {
+	client, err := NewClientWithOptions(WithCredentials("api-key", "shared-secret"), WithPermissionCheck(false))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	client.SetAuthentication(&Authentication{Token: "token", Permissions: PermissionRead})
+
+	if err := client.checkPermission("rtm.tasks.delete"); err != nil {
+		t.Errorf("checkPermission returned error %v, want none", err)
+	}
+}
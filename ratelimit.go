@@ -0,0 +1,100 @@
+package rememberthemilk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRateLimitInterval is the average interval between two API calls
+	// permitted by Remember The Milk.
+	DefaultRateLimitInterval = time.Second
+
+	// DefaultRateLimitBurst is the number of API calls Remember The Milk permits in a burst.
+	DefaultRateLimitBurst = 3
+)
+
+// Limiter limits the rate of API calls made by a Client.
+//
+// Wait blocks until the next call is permitted or ctx is done.
+// The interface is satisfied by *rate.Limiter of golang.org/x/time/rate.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// RateLimiter is a token bucket Limiter.
+// It is safe for concurrent use, so it can be shared by several clients using the same API key.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/ratelimit.rtm
+type RateLimiter struct {
+	interval time.Duration
+	burst    int
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter permitting one call per interval on average
+// and up to burst calls at once. The bucket starts full.
+func NewRateLimiter(interval time.Duration, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		interval: interval,
+		burst:    burst,
+		tokens:   float64(burst),
+	}
+}
+
+// Wait implements the Limiter interface.
+// If ctx is done before the call is permitted, ctx.Err() is returned and the
+// call does not count against the rate limit.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait until it is available.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.interval <= 0 {
+		return 0
+	}
+
+	if !l.last.IsZero() && now.After(l.last) {
+		l.tokens = min(l.tokens+float64(now.Sub(l.last))/float64(l.interval), float64(l.burst))
+	}
+	if now.After(l.last) {
+		l.last = now
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.tokens+1, float64(l.burst))
+}
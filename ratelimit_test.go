@@ -0,0 +1,67 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	l := NewRateLimiter(time.Second, 3)
+	now := time.Now()
+
+	for i := range 3 {
+		if delay := l.reserve(now); delay != 0 {
+			t.Errorf("reserve() #%d = %v, want 0", i+1, delay)
+		}
+	}
+	if delay := l.reserve(now); delay != time.Second {
+		t.Errorf("reserve() after burst = %v, want %v", delay, time.Second)
+	}
+	if delay := l.reserve(now.Add(500 * time.Millisecond)); delay != 1500*time.Millisecond {
+		t.Errorf("reserve() after 500ms = %v, want %v", delay, 1500*time.Millisecond)
+	}
+	if delay := l.reserve(now.Add(time.Hour)); delay != 0 {
+		t.Errorf("reserve() after an hour = %v, want 0", delay)
+	}
+}
+
+func TestRateLimiter_Wait_canceled(t *testing.T) {
+	l := NewRateLimiter(time.Hour, 1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The canceled call must not delay the next one any further.
+	if delay := l.reserve(time.Now()); delay > time.Hour {
+		t.Errorf("reserve() after canceled Wait = %v, want at most %v", delay, time.Hour)
+	}
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	client, mux := setup(t)
+	client.RateLimiter = NewRateLimiter(20*time.Millisecond, 1)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","lists":{"list":[]}}}`)
+	})
+
+	start := time.Now()
+	for range 3 {
+		if _, _, err := client.Lists.GetList(context.Background()); err != nil {
+			t.Fatalf("Lists.GetList returned error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("3 calls took %v, want at least %v", elapsed, 40*time.Millisecond)
+	}
+}
@@ -0,0 +1,233 @@
+package rememberthemilk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+//go:generate go run ./internal/rtmgen
+
+// ReflectionService handles communication with the reflection related
+// methods of the Remember The Milk API.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods.rtm
+type ReflectionService service
+
+type ReflectionGetMethodsResponse struct {
+	Methods MethodNameList `json:"methods"`
+
+	BaseResponse
+}
+
+type MethodNameList struct {
+	Method []MethodName `json:"method"`
+}
+
+// MethodName is the name of an API method, e.g. "rtm.tasks.add".
+type MethodName string
+
+// UnmarshalJSON decodes a method name. The API returns it either as plain
+// string or as object carrying the name as content ("$t") or "name".
+func (n *MethodName) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*n = MethodName(name)
+		return nil
+	}
+
+	var aux struct {
+		Name    string `json:"name"`
+		Content string `json:"$t"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*n = MethodName(aux.Name)
+	if aux.Content != "" {
+		*n = MethodName(aux.Content)
+	}
+	return nil
+}
+
+type ReflectionGetMethodInfoOptions struct {
+	MethodName string `url:"method_name"`
+
+	BaseAPIURLOptions
+}
+
+type ReflectionGetMethodInfoResponse struct {
+	Method MethodInfo `json:"method"`
+
+	BaseResponse
+}
+
+// MethodInfo describes an API method.
+type MethodInfo struct {
+	Name         string `json:"name"`
+	NeedsLogin   string `json:"needslogin"`
+	NeedsSigning string `json:"needssigning"`
+
+	// RequiredPerms is the required permission level from "0" (none) to "3" (delete).
+	// See MethodInfo.Permission.
+	RequiredPerms string           `json:"requiredperms"`
+	Description   string           `json:"description"`
+	Response      string           `json:"response,omitempty"`
+	Arguments     []MethodArgument `json:"arguments"`
+	Errors        []MethodError    `json:"errors,omitempty"`
+}
+
+type MethodArgument struct {
+	Name        string `json:"name"`
+	Optional    string `json:"optional"`
+	Description string `json:"$t"`
+}
+
+type MethodError struct {
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	Description string `json:"$t"`
+}
+
+// UnmarshalJSON decodes a method description and unwraps its arguments and errors.
+// See unmarshalCollection for the format.
+func (m *MethodInfo) UnmarshalJSON(data []byte) error {
+	type methodInfo MethodInfo
+	aux := struct {
+		*methodInfo
+		Arguments json.RawMessage `json:"arguments"`
+		Errors    json.RawMessage `json:"errors"`
+	}{
+		methodInfo: (*methodInfo)(m),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if m.Arguments, err = unmarshalCollection[MethodArgument](aux.Arguments, "argument"); err != nil {
+		return err
+	}
+	if m.Errors, err = unmarshalCollection[MethodError](aux.Errors, "error"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Permission returns the permission required to call the method as one of the Permission* constants.
+// It returns an empty string if the method requires no permission.
+func (m MethodInfo) Permission() string {
+	switch m.RequiredPerms {
+	case "1":
+		return PermissionRead
+	case "2":
+		return PermissionWrite
+	case "3":
+		return PermissionDelete
+	}
+	return ""
+}
+
+// GetMethods retrieves the names of all available API methods.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.reflection.getMethods.rtm
+func (s *ReflectionService) GetMethods(ctx context.Context) ([]string, *Response, error) {
+	opts := s.client.addBaseAPIURLOptions("rtm.reflection.getMethods")
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response struct {
+			ReflectionGetMethodsResponse
+		} `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	methods := make([]string, 0, len(apiResponse.Response.Methods.Method))
+	for _, method := range apiResponse.Response.Methods.Method {
+		methods = append(methods, string(method))
+	}
+	return methods, resp, nil
+}
+
+// GetMethodInfo retrieves the description of the API method methodName, e.g. "rtm.tasks.add".
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.reflection.getMethodInfo.rtm
+func (s *ReflectionService) GetMethodInfo(ctx context.Context, methodName string) (*MethodInfo, *Response, error) {
+	opts := &ReflectionGetMethodInfoOptions{
+		MethodName:        methodName,
+		BaseAPIURLOptions: s.client.addBaseAPIURLOptions("rtm.reflection.getMethodInfo"),
+	}
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response struct {
+			ReflectionGetMethodInfoResponse
+		} `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &apiResponse.Response.Method, resp, nil
+}
+
+// MethodParams is implemented by the generated *Params structs,
+// one for each method of the Remember The Milk API, e.g. TasksAddParams.
+// See Client.Call.
+type MethodParams interface {
+	rtmMethod() string
+	baseOptions() *BaseAPIURLOptions
+}
+
+// baseOptions makes every struct embedding BaseAPIURLOptions expose it.
+func (o *BaseAPIURLOptions) baseOptions() *BaseAPIURLOptions {
+	return o
+}
+
+// Call calls the API method of params, e.g. rtm.tasks.add for a *TasksAddParams.
+// It covers the whole API, including methods without a dedicated service method.
+//
+// Call is the low-level API: the generated *Params structs mirror the raw arguments of
+// the API as strings. The service methods, e.g. TaskService.Add with TaskInput, are the
+// supported API with typed inputs and responses; prefer them where they exist.
+//
+// The complete JSON response, including the surrounding "rsp" object, is decoded
+// into v. See Client.Do for how v is handled.
+func (c *Client) Call(ctx context.Context, params MethodParams, v any) (*Response, error) {
+	if params == nil {
+		return nil, errors.New("params must be non-nil")
+	}
+
+	*params.baseOptions() = c.addBaseAPIURLOptions(params.rtmMethod())
+	u, err := c.addOptions("", params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req, v)
+}
@@ -0,0 +1,73 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestReflectionService_GetMethodInfo(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.reflection.getMethodInfo")
+		testParam(t, r, "method_name", "rtm.test.login")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","method":{"name":"rtm.test.login","needslogin":"1","needssigning":"1","requiredperms":"1",
+			"description":"A testing method which checks if the caller is logged in.",
+			"arguments":{"argument":[{"name":"api_key","optional":"0","$t":"Your API application key."}]},
+			"errors":{"error":[{"code":"96","message":"Invalid signature","$t":"The passed signature was invalid."}]}}}}`)
+	})
+
+	method, _, err := client.Reflection.GetMethodInfo(context.Background(), "rtm.test.login")
+	if err != nil {
+		t.Fatalf("Reflection.GetMethodInfo returned error: %v", err)
+	}
+
+	want := &MethodInfo{
+		Name:          "rtm.test.login",
+		NeedsLogin:    "1",
+		NeedsSigning:  "1",
+		RequiredPerms: "1",
+		Description:   "A testing method which checks if the caller is logged in.",
+		Arguments:     []MethodArgument{{Name: "api_key", Optional: "0", Description: "Your API application key."}},
+		Errors:        []MethodError{{Code: "96", Message: "Invalid signature", Description: "The passed signature was invalid."}},
+	}
+	if !reflect.DeepEqual(method, want) {
+		t.Errorf("Reflection.GetMethodInfo returned %+v, want %+v", method, want)
+	}
+	if method.Permission() != PermissionRead {
+		t.Errorf("Permission returned %q, want %q", method.Permission(), PermissionRead)
+	}
+}
+
+func TestClient_Call(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.setName")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "name", "Get Apples")
+		testParam(t, r, "api_key", "api-key")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"}}}`)
+	})
+
+	params := &TasksSetNameParams{
+		Timeline:     "12741021",
+		ListID:       "100653",
+		TaskseriesID: "117192",
+		TaskID:       "216859",
+		Name:         "Get Apples",
+	}
+	var result struct {
+		Response TaskModifyResponse `json:"rsp"`
+	}
+	if _, err := client.Call(context.Background(), params, &result); err != nil {
+		t.Fatalf("Call returned error: %v", err)
+	}
+
+	if result.Response.Transaction.ID != "4711" {
+		t.Errorf("Call returned transaction %q, want %q", result.Response.Transaction.ID, "4711")
+	}
+}
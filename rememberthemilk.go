@@ -8,12 +8,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -35,9 +38,15 @@ var errNonNilContext = errors.New("context must be non-nil")
 
 // A Client manages communication with the Remember The Milk API.
 type Client struct {
-	apiKey              string
-	sharedSecret        string
+	apiKey       string
+	sharedSecret string
+	apiVersion   string
+
+	// authMu guards authenticationToken, permissions and tokenStore, which can change during the lifetime of the client.
+	authMu              sync.RWMutex
 	authenticationToken string
+	permissions         string
+	tokenStore          TokenStore
 
 	// HTTP client used to communicate with the API.
 	client *http.Client
@@ -53,6 +62,34 @@ type Client struct {
 	// User agent used when communicating with the Remember The Milk API.
 	UserAgent string
 
+	// RateLimiter delays API calls to stay within the rate limit of Remember The Milk.
+	// NewClient sets a RateLimiter with DefaultRateLimitInterval and DefaultRateLimitBurst.
+	// Requests are not limited if RateLimiter is nil.
+	RateLimiter Limiter
+
+	// DisablePermissionCheck turns off the local permission check, which rejects calls
+	// requiring a higher permission than the authentication token grants before they
+	// reach the API. See Client.Permissions.
+	DisablePermissionCheck bool
+
+	// RetryPolicy decides whether failed requests are re-sent.
+	// Requests are not retried if RetryPolicy is nil.
+	RetryPolicy *RetryPolicy
+
+	// Logger logs every attempt of an API call with its method, attempt number, duration, HTTP status, stat and error code.
+	// Credentials are redacted from logged URLs. Calls are not logged if Logger is nil.
+	Logger *slog.Logger
+
+	// middleware wraps every API call, see Use.
+	middleware []Middleware
+
+	// attemptMiddleware wraps every attempt of an API call, see UseAttempt.
+	attemptMiddleware []Middleware
+
+	// Journal records the undoable transactions of all requests made with a timeline.
+	// Recording is disabled if Journal is nil. See TransactionsService.Rollback.
+	Journal *TransactionJournal
+
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
@@ -61,9 +98,17 @@ type Client struct {
 	Tags           *TagService
 	Lists          *ListService
 	Contacts       *ContactsService
+	Groups         *GroupsService
+	Locations      *LocationsService
+	Reflection     *ReflectionService
+	Settings       *SettingsService
 	Timelines      *TimelineService
+	Transactions   *TransactionsService
 	Tasks          *TaskService
+	Notes          *NotesService
 	Test           *TestService
+	Time           *TimeService
+	Timezones      *TimezonesService
 }
 
 type service struct {
@@ -119,6 +164,9 @@ func (c *Client) addOptions(s string, opts any) (string, error) {
 
 // NewClient returns a new Remember the Milk API client. If a nil httpClient is
 // provided, a new http.Client will be used.
+//
+// The client limits its API calls to the rate documented by Remember The Milk.
+// See Client.RateLimiter.
 func NewClient(apiKey, sharedSecret, token string, httpClient *http.Client) *Client {
 	if httpClient == nil {
 		httpClient = &http.Client{}
@@ -129,6 +177,7 @@ func NewClient(apiKey, sharedSecret, token string, httpClient *http.Client) *Cli
 		sharedSecret:        sharedSecret,
 		authenticationToken: token,
 		client:              &httpClient2,
+		RateLimiter:         NewRateLimiter(DefaultRateLimitInterval, DefaultRateLimitBurst),
 	}
 	c.initialize()
 	return c
@@ -149,22 +198,65 @@ func (c *Client) initialize() {
 	if c.UserAgent == "" {
 		c.UserAgent = defaultUserAgent
 	}
+	if c.apiVersion == "" {
+		c.apiVersion = defaultAPIVersion
+	}
 
 	c.common.client = c
 	c.Authentication = (*AuthenticationService)(&c.common)
 	c.Tags = (*TagService)(&c.common)
 	c.Lists = (*ListService)(&c.common)
 	c.Contacts = (*ContactsService)(&c.common)
+	c.Groups = (*GroupsService)(&c.common)
+	c.Locations = (*LocationsService)(&c.common)
+	c.Reflection = (*ReflectionService)(&c.common)
+	c.Settings = (*SettingsService)(&c.common)
 	c.Timelines = (*TimelineService)(&c.common)
+	c.Transactions = (*TransactionsService)(&c.common)
 	c.Tasks = (*TaskService)(&c.common)
+	c.Notes = (*NotesService)(&c.common)
 	c.Test = (*TestService)(&c.common)
+	c.Time = (*TimeService)(&c.common)
+	c.Timezones = (*TimezonesService)(&c.common)
 }
 
 // SetAuthenticationToken sets the authentication token to be used in API requests.
+// The permission level of the token is unknown until AuthenticationService.CheckToken is called.
 //
 // This token is required for making authenticated requests to the Remember The Milk API.
 func (c *Client) SetAuthenticationToken(token string) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
 	c.authenticationToken = token
+	c.permissions = ""
+}
+
+// SetAuthentication sets the authentication token and its permission level,
+// e.g. as returned by AuthenticationService.GetToken.
+func (c *Client) SetAuthentication(auth *Authentication) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	c.authenticationToken = auth.Token
+	c.permissions = auth.Permissions
+}
+
+// Permissions returns the permission level of the authentication token, see constants Permission*.
+// It returns an empty string if the permission level is unknown.
+//
+// If the permission level is known, API methods requiring a higher permission
+// fail locally with an *InsufficientPermissionError instead of calling the API,
+// unless DisablePermissionCheck is set.
+func (c *Client) Permissions() string {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.permissions
+}
+
+// authentication returns the authentication token.
+func (c *Client) authentication() string {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.authenticationToken
 }
 
 // RequestOption represents an option that can modify an http.Request.
@@ -200,6 +292,9 @@ func (c *Client) NewRequest(method, urlStr string, body any, opts ...RequestOpti
 	if err != nil {
 		return nil, err
 	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 	for _, opt := range opts {
 		opt(req)
 	}
@@ -211,6 +306,14 @@ func (c *Client) NewRequest(method, urlStr string, body any, opts ...RequestOpti
 // returned from Remember the Milk and provides convenient access to API specific things.
 type Response struct {
 	*http.Response
+
+	// Stat is the status of the API response, StatOK or StatFail.
+	// It is empty if the response carries no status, e.g. if the rate limit was exceeded.
+	Stat string
+
+	// Transaction is the transaction of a request made with a timeline.
+	// It is nil if the API response contains no transaction.
+	Transaction *Transaction
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -225,6 +328,9 @@ func newResponse(r *http.Response) *Response {
 // will contain more information. Otherwise you are supposed to read and close the
 // response's Body.
 //
+// The request passes through the middleware of the Client, see Client.Use.
+// If the Client has a Logger, every attempt of the request is logged after passing the middleware.
+//
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is
 // canceled or times out, ctx.Err() will be returned.
 func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Request) (*Response, error) {
@@ -232,7 +338,66 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 		return nil, errNonNilContext
 	}
 
-	req = req.WithContext(ctx)
+	call := newCall(c, req)
+	resp, err := chain(c.middleware, c.roundTrip(caller))(ctx, call)
+	if err == nil && (resp == nil || resp.Response == nil) {
+		return nil, errNoResponse(call)
+	}
+	return resp, err
+}
+
+// roundTrip returns the CallHandler at the end of the middleware chain.
+// It checks the permission of the call and sends it, honoring the RetryPolicy of the Client.
+// Every attempt passes through the attempt middleware, see Client.UseAttempt.
+func (c *Client) roundTrip(caller *http.Client) CallHandler {
+	attemptMiddleware := c.attemptMiddleware
+	if c.Logger != nil {
+		attemptMiddleware = append(attemptMiddleware[:len(attemptMiddleware):len(attemptMiddleware)], logCalls(c.Logger))
+	}
+	send := chain(attemptMiddleware, func(ctx context.Context, call *Call) (*Response, error) {
+		return c.send(ctx, caller, call.Request.WithContext(ctx))
+	})
+
+	return func(ctx context.Context, call *Call) (*Response, error) {
+		if err := c.checkPermission(call.Method); err != nil {
+			return nil, err
+		}
+
+		for attempt := 1; ; attempt++ {
+			call.Attempt = attempt
+			response, err := send(ctx, call)
+			if err == nil && (response == nil || response.Response == nil) {
+				return nil, errNoResponse(call)
+			}
+
+			req := call.Request.WithContext(ctx)
+			delay, retry := c.RetryPolicy.retry(attempt, req, response, err)
+			if !retry {
+				if err == nil {
+					c.recordTransaction(req, response)
+				}
+				return response, err
+			}
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return response, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// send waits for the RateLimiter, sends req once and checks the response for errors.
+// If an error occurs, the response body is already closed.
+func (c *Client) send(ctx context.Context, caller *http.Client, req *http.Request) (*Response, error) {
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	resp, err := caller.Do(req)
 	var response *Response
@@ -252,11 +417,13 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 		return response, err
 	}
 
-	err = CheckResponse(resp)
+	err = response.check()
 	if err != nil {
 		defer resp.Body.Close()
+		return response, err
 	}
-	return response, err
+
+	return response, nil
 }
 
 // BareDo sends an API request and lets you handle the api response. If an error
@@ -300,6 +467,30 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*Response, e
 	return resp, err
 }
 
+// doAPI sends the API request described by opts and decodes the "rsp" object of the response into a T.
+// The API method is taken from the BaseAPIURLOptions embedded in opts.
+func doAPI[T any](ctx context.Context, c *Client, opts any) (*T, *Response, error) {
+	u, err := c.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response T `json:"rsp"`
+	}
+	resp, err := c.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &apiResponse.Response, resp, nil
+}
+
 // ErrorResponse reports an error caused by an API request.
 //
 // Remember the Milk API docs: https://www.rememberthemilk.com/services/api/response.rtm
@@ -317,11 +508,16 @@ func (r *ErrorResponse) Error() string {
 // CheckResponse checks the API response for errors, and returns them if
 // present. A response is considered an error the response contains `stat`="fail".
 func CheckResponse(r *http.Response) error {
+	return newResponse(r).check()
+}
+
+// check implements CheckResponse and populates Stat and Transaction of r.
+func (r *Response) check() error {
 	// HTTP error 503 - Service Temporarily Unavailable means "Rate limit hit"
 	// See https://www.rememberthemilk.com/services/api/ratelimit.rtm
 	if r.StatusCode == http.StatusServiceUnavailable {
 		return &ErrorResponse{
-			Response: r,
+			Response: r.Response,
 			Code:     r.StatusCode,
 			Message:  "Rate limit exceeded. See https://www.rememberthemilk.com/services/api/ratelimit.rtm",
 		}
@@ -329,19 +525,22 @@ func CheckResponse(r *http.Response) error {
 
 	// The API returns always 200 OK even if an error appears.
 	// So we need to parse the response body to check if an error appears.
-	errorResponse := &ErrorResponse{Response: r}
+	errorResponse := &ErrorResponse{Response: r.Response}
 	data, err := io.ReadAll(r.Body)
 	if err == nil && data != nil {
 		var apiResponse struct {
 			Response struct {
 				BaseResponse
+				Transaction *Transaction `json:"transaction"`
 			} `json:"rsp"`
 		}
 		err = json.Unmarshal(data, &apiResponse)
 		if err != nil {
 			// reset the response as if this never happened
-			errorResponse = &ErrorResponse{Response: r}
+			errorResponse = &ErrorResponse{Response: r.Response}
 		}
+		r.Stat = apiResponse.Response.Stat
+		r.Transaction = apiResponse.Response.Transaction
 
 		if apiResponse.Response.Stat == "fail" {
 			errorCode, err := strconv.Atoi(apiResponse.Response.Error.Code)
@@ -360,6 +559,37 @@ func CheckResponse(r *http.Response) error {
 	return nil
 }
 
+// unmarshalCollection decodes a collection in the format the Remember The Milk API uses for nested lists.
+// An empty collection is returned as an empty array ([]), a populated one as an object
+// wrapping the array under key, e.g. {"tag": ["a", "b"]}.
+func unmarshalCollection[T any](data []byte, key string) ([]T, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	if data[0] == '[' {
+		var items []T
+		if err := json.Unmarshal(data, &items); err != nil || len(items) == 0 {
+			return nil, err
+		}
+		return items, nil
+	}
+
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, err
+	}
+	raw, ok := wrapper[key]
+	if !ok {
+		return nil, nil
+	}
+
+	var items []T
+	err := json.Unmarshal(raw, &items)
+	return items, err
+}
+
 // SignRequest signs a request according to the Remember The Milk API specification.
 // It takes a map of parameters, sorts them by key, concatenates them with the shared secret,
 // and returns the MD5 hash that should be used as the api_sig parameter.
@@ -392,12 +622,12 @@ func (c *Client) addBaseAPIURLOptions(method string) BaseAPIURLOptions {
 	opts := BaseAPIURLOptions{
 		Method:  method,
 		Format:  ResponseFormatJSON,
-		Version: defaultAPIVersion,
+		Version: c.apiVersion,
 		APIKey:  c.apiKey,
 	}
 
-	if len(c.authenticationToken) > 0 {
-		opts.AuthenticationToken = c.authenticationToken
+	if token := c.authentication(); len(token) > 0 {
+		opts.AuthenticationToken = token
 	}
 
 	return opts
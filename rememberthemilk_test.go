@@ -1,10 +1,50 @@
 package rememberthemilk
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 )
 
+// setup sets up a test HTTP server along with a Client that is configured
+// to talk to that test server. Tests should register a handler on mux which
+// provides mock responses for the API method being tested.
+func setup(t *testing.T) (*Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClient("api-key", "shared-secret", "token", nil)
+	u, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.BaseURL = u
+	client.WebBaseURL = u
+	client.RateLimiter = nil
+
+	return client, mux
+}
+
+// testMethod checks that the request r calls the Remember The Milk API method want.
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+	if got := r.URL.Query().Get("method"); got != want {
+		t.Errorf("Request method: %v, want %v", got, want)
+	}
+}
+
+// testParam checks that the request r carries the query parameter key with value want.
+func testParam(t *testing.T, r *http.Request, key, want string) {
+	t.Helper()
+	if got := r.URL.Query().Get(key); got != want {
+		t.Errorf("Request parameter %q: %v, want %v", key, got, want)
+	}
+}
+
 func TestClient_SignRequest(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,113 @@
+package rememberthemilk
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryBaseDelay = time.Second
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+// RetryPolicy re-sends requests which failed because the API was rate limited
+// or temporarily unavailable. Set it as Client.RetryPolicy to enable retries.
+//
+// Requests the API did not process, i.e. it answered with ErrRateLimited or
+// ErrServiceUnavailable, are always safe to re-send. Requests failing with
+// a network error might have been processed, so they are only re-sent if they
+// are reads. Writes, i.e. requests carrying a timeline, are not re-sent then.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per request, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the wait time before the first retry. It doubles with every
+	// further retry up to MaxDelay. A random jitter of up to half the delay is
+	// subtracted. Defaults to 1 second.
+	BaseDelay time.Duration
+
+	// MaxDelay is the maximum wait time between two attempts. Defaults to 30 seconds.
+	// A Retry-After header of the API takes precedence.
+	MaxDelay time.Duration
+}
+
+// NewRetryPolicy returns a RetryPolicy with maxAttempts attempts and the default delays.
+func NewRetryPolicy(maxAttempts int) *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   defaultRetryBaseDelay,
+		MaxDelay:    defaultRetryMaxDelay,
+	}
+}
+
+// retry decides whether the attempt'th attempt of req, which resulted in resp and err,
+// is retried and how long to wait before. It is safe to call on a nil RetryPolicy.
+func (p *RetryPolicy) retry(attempt int, req *http.Request, resp *Response, err error) (time.Duration, bool) {
+	if p == nil || err == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if req.Context().Err() != nil {
+		return 0, false
+	}
+	// Requests with a body cannot be re-sent.
+	if req.Body != nil && req.Body != http.NoBody {
+		return 0, false
+	}
+
+	var errorResponse *ErrorResponse
+	switch {
+	case errors.Is(err, ErrRateLimited), errors.Is(err, ErrServiceUnavailable):
+		// The API did not process the request.
+	case errors.As(err, &errorResponse):
+		// Any other API error will not go away by retrying.
+		return 0, false
+	case req.URL.Query().Get("timeline") != "":
+		// The write might have been processed before the connection failed.
+		return 0, false
+	}
+
+	if resp != nil {
+		if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay, true
+		}
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff returns the exponential backoff with jitter after the attempt'th attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = defaultRetryBaseDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+
+	delay := base
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+
+	return delay - rand.N(delay/2+1)
+}
+
+// retryAfter parses the value of a Retry-After header, either in seconds or as HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
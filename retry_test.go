@@ -0,0 +1,108 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_RetryPolicy(t *testing.T) {
+	client, mux := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","lists":{"list":[]}}}`)
+	})
+
+	if _, _, err := client.Lists.GetList(context.Background()); err != nil {
+		t.Fatalf("Lists.GetList returned error: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Lists.GetList made %d attempts, want 3", attempts)
+	}
+}
+
+func TestClient_RetryPolicy_maxAttempts(t *testing.T) {
+	client, mux := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		fmt.Fprint(w, `{"rsp":{"stat":"fail","err":{"code":"105","msg":"Service currently unavailable"}}}`)
+	})
+
+	_, _, err := client.Lists.GetList(context.Background())
+	if !errors.Is(err, ErrServiceUnavailable) {
+		t.Errorf("Lists.GetList returned error %v, want %v", err, ErrServiceUnavailable)
+	}
+	if attempts != 2 {
+		t.Errorf("Lists.GetList made %d attempts, want 2", attempts)
+	}
+}
+
+func TestRetryPolicy_retry(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	read, _ := http.NewRequest("GET", "https://example.com/?method=rtm.lists.getList", nil)
+	write, _ := http.NewRequest("GET", "https://example.com/?method=rtm.lists.add&timeline=1", nil)
+	networkErr := errors.New("connection reset")
+
+	tests := []struct {
+		name    string
+		policy  *RetryPolicy
+		attempt int
+		req     *http.Request
+		err     error
+		want    bool
+	}{
+		{name: "no policy", policy: nil, attempt: 1, req: read, err: ErrRateLimited, want: false},
+		{name: "success", policy: policy, attempt: 1, req: read, err: nil, want: false},
+		{name: "rate limited write", policy: policy, attempt: 1, req: write, err: &ErrorResponse{Code: http.StatusServiceUnavailable}, want: true},
+		{name: "unavailable write", policy: policy, attempt: 2, req: write, err: &ErrorResponse{Code: 105}, want: true},
+		{name: "attempts exhausted", policy: policy, attempt: 3, req: read, err: &ErrorResponse{Code: 105}, want: false},
+		{name: "network error read", policy: policy, attempt: 1, req: read, err: networkErr, want: true},
+		{name: "network error write", policy: policy, attempt: 1, req: write, err: networkErr, want: false},
+		{name: "other API error", policy: policy, attempt: 1, req: read, err: &ErrorResponse{Code: 340}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := tt.policy.retry(tt.attempt, tt.req, nil, tt.err); got != tt.want {
+				t.Errorf("retry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 10: 5 * time.Second} {
+		got := policy.backoff(attempt)
+		if got < want/2 || got > want {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", attempt, got, want/2, want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if got, ok := retryAfter("7"); !ok || got != 7*time.Second {
+		t.Errorf("retryAfter(\"7\") = %v, %v, want 7s, true", got, ok)
+	}
+	if got, ok := retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)); !ok || got < 59*time.Minute {
+		t.Errorf("retryAfter(date) = %v, %v, want about 1h, true", got, ok)
+	}
+	if _, ok := retryAfter("soon"); ok {
+		t.Error("retryAfter(\"soon\") = true, want false")
+	}
+}
@@ -0,0 +1,261 @@
+package rememberthemilk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequencies of a recurrence rule. See RRule.Freq.
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+	FreqYearly  = "YEARLY"
+)
+
+// Layouts of the UNTIL part of a recurrence rule.
+const (
+	rruleUntilLayout     = "20060102T150405"
+	rruleUntilDateLayout = "20060102"
+)
+
+// RRule represents the recurrence rule of a taskseries.
+// It models the iCalendar RRULE (RFC 5545) used by the Remember The Milk API, e.g. "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,FR".
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/tasks.rtm
+type RRule struct {
+	// Rule is the iCalendar RRULE as returned by the API or passed to ParseRRule, e.g. "FREQ=WEEKLY;INTERVAL=1".
+	// It is kept even if it cannot be parsed into the fields below. It is not updated if the fields change.
+	Rule string
+
+	// Every reports whether the task repeats on a fixed schedule ("every week").
+	// If false, the task repeats relative to its completion ("after a week").
+	Every bool
+
+	// Freq is one of the Freq* constants.
+	Freq string
+
+	// Interval is the number of Freq units between two occurrences.
+	// Zero is omitted and means 1.
+	Interval int
+
+	// ByDay lists the weekdays of the occurrences, optionally prefixed by an ordinal, e.g. "MO" or "-1FR".
+	ByDay []string
+
+	// Until is the end of the recurrence. Zero means no end.
+	Until time.Time
+
+	// untilLayout is the layout Until was parsed from, so String renders it unchanged.
+	untilLayout string
+
+	// Count is the number of occurrences. Zero means no limit.
+	Count int
+
+	// Other keeps rule parts which are not modeled above, e.g. "BYMONTHDAY=15", in their original order.
+	Other []string
+}
+
+// ParseRRule parses an iCalendar RRULE, e.g. "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,FR".
+// Empty parts are skipped. The returned rule has Every set to true.
+func ParseRRule(s string) (*RRule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := &RRule{Rule: s, Every: true}
+	if s == "" {
+		return r, nil
+	}
+
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rrule part %q", part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = value
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+		case "BYDAY":
+			r.ByDay = strings.Split(value, ",")
+		case "UNTIL":
+			r.Until, r.untilLayout, err = parseRRuleUntil(value)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+		default:
+			r.Other = append(r.Other, part)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid rrule part %q: %w", part, err)
+		}
+	}
+
+	return r, nil
+}
+
+// parseRRuleUntil parses the value of an UNTIL part and returns its layout.
+// It is either a date, a floating date-time or a UTC date-time.
+func parseRRuleUntil(value string) (time.Time, string, error) {
+	for _, layout := range []string{rruleUntilLayout + "Z", rruleUntilLayout, rruleUntilDateLayout} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, layout, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("cannot parse %q as date", value)
+}
+
+// String renders the rule as iCalendar RRULE without the "RRULE:" prefix.
+// UNTIL is rendered in the form it was parsed from, by default as floating date-time in UTC.
+// If none of the parsed fields is set, e.g. because Rule could not be parsed, Rule is returned.
+//
+// Every is not part of the RRULE and therefore not rendered. See Repeat for a form which keeps it.
+func (r RRule) String() string {
+	if r.Freq == "" && r.Interval == 0 && len(r.ByDay) == 0 && r.Until.IsZero() && r.Count == 0 && len(r.Other) == 0 {
+		return r.Rule
+	}
+
+	var parts []string
+	if r.Freq != "" {
+		parts = append(parts, "FREQ="+r.Freq)
+	}
+	if r.Interval > 0 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		parts = append(parts, "BYDAY="+strings.Join(r.ByDay, ","))
+	}
+	if !r.Until.IsZero() {
+		layout := r.untilLayout
+		if layout == "" {
+			layout = rruleUntilLayout
+		}
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(layout))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	parts = append(parts, r.Other...)
+	return strings.Join(parts, ";")
+}
+
+// freqUnits maps the frequencies to the units of the natural language form, see RRule.Repeat.
+var freqUnits = map[string]string{
+	FreqDaily:   "day",
+	FreqWeekly:  "week",
+	FreqMonthly: "month",
+	FreqYearly:  "year",
+}
+
+// weekdays maps the weekdays of BYDAY to the names of the natural language form, see RRule.Repeat.
+var weekdays = map[string]string{
+	"MO": "monday",
+	"TU": "tuesday",
+	"WE": "wednesday",
+	"TH": "thursday",
+	"FR": "friday",
+	"SA": "saturday",
+	"SU": "sunday",
+}
+
+// Repeat renders the rule in the natural language form of Remember The Milk,
+// e.g. "every 2 weeks", "after 3 days" or "every monday and friday until 2025-03-01".
+// It is the form TaskService.SetRecurrence sends for TaskSetRecurrenceInput.Rule.
+//
+// Repeat returns an error for rules the natural language form cannot express:
+// rules without Freq, with parts in Other, with both Until and Count, or with ByDay
+// on anything but a weekly fixed schedule with an interval of 1 and without ordinals.
+func (r RRule) Repeat() (string, error) {
+	unit, ok := freqUnits[strings.ToUpper(r.Freq)]
+	if !ok {
+		return "", fmt.Errorf("cannot express frequency %q of rrule %q", r.Freq, r.String())
+	}
+	if len(r.Other) > 0 {
+		return "", fmt.Errorf("cannot express %s of rrule %q", strings.Join(r.Other, ";"), r.String())
+	}
+	if !r.Until.IsZero() && r.Count > 0 {
+		return "", fmt.Errorf("cannot express both UNTIL and COUNT of rrule %q", r.String())
+	}
+
+	var b strings.Builder
+	if r.Every {
+		b.WriteString("every ")
+	} else {
+		b.WriteString("after ")
+	}
+
+	switch {
+	case len(r.ByDay) > 0:
+		if !r.Every || unit != "week" || r.Interval > 1 {
+			return "", fmt.Errorf("cannot express BYDAY of rrule %q", r.String())
+		}
+		names := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			name, ok := weekdays[strings.ToUpper(day)]
+			if !ok {
+				return "", fmt.Errorf("cannot express weekday %q of rrule %q", day, r.String())
+			}
+			names = append(names, name)
+		}
+		if last := len(names) - 1; last > 0 {
+			b.WriteString(strings.Join(names[:last], ", ") + " and " + names[last])
+		} else {
+			b.WriteString(names[0])
+		}
+	case r.Interval > 1:
+		fmt.Fprintf(&b, "%d %ss", r.Interval, unit)
+	default:
+		b.WriteString(unit)
+	}
+
+	if !r.Until.IsZero() {
+		b.WriteString(" until " + r.Until.Format(time.DateOnly))
+	}
+	if r.Count > 0 {
+		fmt.Fprintf(&b, " for %d times", r.Count)
+	}
+	return b.String(), nil
+}
+
+// UnmarshalJSON decodes a rrule as returned by the API, e.g. {"every": "1", "$t": "FREQ=WEEKLY;INTERVAL=1"}.
+// A rule which cannot be parsed does not fail decoding; only Rule and Every are set then.
+func (r *RRule) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Every json.RawMessage `json:"every"`
+		Rule  string          `json:"$t"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	parsed, err := ParseRRule(aux.Rule)
+	if err != nil {
+		parsed = &RRule{Rule: aux.Rule}
+	}
+	*r = *parsed
+
+	switch string(aux.Every) {
+	case `"1"`, `1`, `true`:
+		r.Every = true
+	default:
+		r.Every = false
+	}
+	return nil
+}
+
+// MarshalJSON encodes a rrule in the format of the API.
+func (r RRule) MarshalJSON() ([]byte, error) {
+	every := "0"
+	if r.Every {
+		every = "1"
+	}
+	return json.Marshal(struct {
+		Every string `json:"every"`
+		Rule  string `json:"$t"`
+	}{every, r.String()})
+}
@@ -0,0 +1,174 @@
+package rememberthemilk
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		want *RRule
+	}{
+		{
+			name: "weekly",
+			rule: "FREQ=WEEKLY;INTERVAL=1",
+			want: &RRule{Rule: "FREQ=WEEKLY;INTERVAL=1", Every: true, Freq: FreqWeekly, Interval: 1},
+		},
+		{
+			name: "weekdays until",
+			rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;UNTIL=20250301T000000",
+			want: &RRule{Rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;UNTIL=20250301T000000", Every: true, Freq: FreqWeekly, Interval: 2, ByDay: []string{"MO", "FR"}, Until: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), untilLayout: rruleUntilLayout},
+		},
+		{
+			name: "until in UTC",
+			rule: "FREQ=DAILY;UNTIL=20250301T120000Z",
+			want: &RRule{Rule: "FREQ=DAILY;UNTIL=20250301T120000Z", Every: true, Freq: FreqDaily, Until: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), untilLayout: rruleUntilLayout + "Z"},
+		},
+		{
+			name: "until date",
+			rule: "FREQ=DAILY;UNTIL=20250301",
+			want: &RRule{Rule: "FREQ=DAILY;UNTIL=20250301", Every: true, Freq: FreqDaily, Until: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), untilLayout: rruleUntilDateLayout},
+		},
+		{
+			name: "count and unknown parts",
+			rule: "FREQ=MONTHLY;INTERVAL=1;COUNT=3;BYMONTHDAY=15",
+			want: &RRule{Rule: "FREQ=MONTHLY;INTERVAL=1;COUNT=3;BYMONTHDAY=15", Every: true, Freq: FreqMonthly, Interval: 1, Count: 3, Other: []string{"BYMONTHDAY=15"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRRule returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRRule = %+v, want %+v", got, tt.want)
+			}
+			if s := got.String(); s != tt.rule {
+				t.Errorf("String = %q, want %q", s, tt.rule)
+			}
+		})
+	}
+}
+
+func TestParseRRule_invalid(t *testing.T) {
+	for _, rule := range []string{"FREQ", "FREQ=DAILY;COUNT=x", "FREQ=DAILY;UNTIL=tomorrow"} {
+		if _, err := ParseRRule(rule); err == nil {
+			t.Errorf("ParseRRule(%q) returned no error", rule)
+		}
+	}
+}
+
+func TestRRule_JSON(t *testing.T) {
+	data := `{"every":"0","$t":"FREQ=DAILY;INTERVAL=3"}`
+
+	var r RRule
+	if err := json.Unmarshal([]byte(data), &r); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	want := RRule{Rule: "FREQ=DAILY;INTERVAL=3", Every: false, Freq: FreqDaily, Interval: 3}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("json.Unmarshal = %+v, want %+v", r, want)
+	}
+
+	got, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if string(got) != data {
+		t.Errorf("json.Marshal = %s, want %s", got, data)
+	}
+}
+
+func TestParseRRule_emptyParts(t *testing.T) {
+	got, err := ParseRRule("FREQ=DAILY;;INTERVAL=2;")
+	if err != nil {
+		t.Fatalf("ParseRRule returned error: %v", err)
+	}
+	if got.Freq != FreqDaily || got.Interval != 2 {
+		t.Errorf("ParseRRule = %+v, want FREQ=DAILY and INTERVAL=2", got)
+	}
+}
+
+func TestRRule_String_default(t *testing.T) {
+	r := RRule{Freq: FreqDaily, Until: time.Date(2025, 3, 1, 13, 0, 0, 0, time.FixedZone("CET", 3600))}
+	if got, want := r.String(), "FREQ=DAILY;UNTIL=20250301T120000"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+}
+
+func TestRRule_JSON_unparsable(t *testing.T) {
+	data := `{"every":"1","$t":"FREQ=DAILY;COUNT=many"}`
+
+	var r RRule
+	if err := json.Unmarshal([]byte(data), &r); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	want := RRule{Rule: "FREQ=DAILY;COUNT=many", Every: true}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("json.Unmarshal = %+v, want %+v", r, want)
+	}
+	if got := r.String(); got != want.Rule {
+		t.Errorf("String = %q, want %q", got, want.Rule)
+	}
+}
+
+func TestRRule_Repeat(t *testing.T) {
+	tests := []struct {
+		name string
+		rule RRule
+		want string
+	}{
+		{
+			name: "every week",
+			rule: RRule{Every: true, Freq: FreqWeekly, Interval: 1},
+			want: "every week",
+		},
+		{
+			name: "after days",
+			rule: RRule{Freq: FreqDaily, Interval: 3},
+			want: "after 3 days",
+		},
+		{
+			name: "weekdays until",
+			rule: RRule{Every: true, Freq: FreqWeekly, ByDay: []string{"MO", "WE", "FR"}, Until: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+			want: "every monday, wednesday and friday until 2025-03-01",
+		},
+		{
+			name: "count",
+			rule: RRule{Every: true, Freq: FreqMonthly, Interval: 2, Count: 4},
+			want: "every 2 months for 4 times",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rule.Repeat()
+			if err != nil {
+				t.Fatalf("Repeat returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Repeat = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRule_Repeat_invalid(t *testing.T) {
+	for _, rule := range []RRule{
+		{Every: true},
+		{Every: true, Freq: FreqMonthly, Other: []string{"BYMONTHDAY=15"}},
+		{Every: true, Freq: FreqDaily, Count: 3, Until: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Freq: FreqWeekly, ByDay: []string{"MO"}},
+		{Every: true, Freq: FreqMonthly, ByDay: []string{"-1FR"}},
+	} {
+		if got, err := rule.Repeat(); err == nil {
+			t.Errorf("Repeat of %+v = %q, want an error", rule, got)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// SettingsService handles communication with the settings related
+// methods of the Remember The Milk API.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods.rtm
+type SettingsService service
+
+// Date and time formats of the user. See Settings.DateFormat and Settings.TimeFormat.
+const (
+	DateFormatEuropean = "0"
+	DateFormatAmerican = "1"
+
+	TimeFormat12Hour = "0"
+	TimeFormat24Hour = "1"
+)
+
+type SettingsGetListResponse struct {
+	Settings Settings `json:"settings"`
+
+	BaseResponse
+}
+
+// Settings represents the settings of the authenticated user.
+type Settings struct {
+	// Timezone is the IANA name of the user's timezone, e.g. "Europe/Berlin".
+	Timezone string `json:"timezone"`
+
+	// DateFormat is one of the DateFormat* constants.
+	DateFormat string `json:"dateformat"`
+
+	// TimeFormat is one of the TimeFormat* constants.
+	TimeFormat string `json:"timeformat"`
+
+	// DefaultList is the ID of the list tasks are added to if no list is specified.
+	DefaultList string `json:"defaultlist"`
+
+	// Language is the user's language, e.g. "en-US".
+	Language string `json:"language"`
+
+	// DefaultDueDate is the due date of new tasks, e.g. "never" or "today".
+	DefaultDueDate string `json:"defaultduedate"`
+
+	// Pro reports whether the user has a Pro account ("1") or not ("0").
+	Pro string `json:"pro"`
+}
+
+// Location returns the time.Location of Settings.Timezone.
+// It returns an error if Timezone is empty, instead of falling back to UTC like time.LoadLocation.
+func (s Settings) Location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return nil, errors.New("settings have no timezone")
+	}
+	return time.LoadLocation(s.Timezone)
+}
+
+// IsPro reports whether the user has a Pro account.
+func (s Settings) IsPro() bool {
+	return s.Pro == "1"
+}
+
+// GetList retrieves the settings of the authenticated user.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.settings.getList.rtm
+func (s *SettingsService) GetList(ctx context.Context) (*Settings, *Response, error) {
+	opts := s.client.addBaseAPIURLOptions("rtm.settings.getList")
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response struct {
+			SettingsGetListResponse
+		} `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &apiResponse.Response.Settings, resp, nil
+}
@@ -0,0 +1,54 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSettingsService_GetList(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.settings.getList")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","settings":{"timezone":"Australia/Sydney","dateformat":"0","timeformat":"1",
+			"defaultlist":"123456","language":"en-AU","defaultduedate":"today","pro":"1"}}}`)
+	})
+
+	settings, _, err := client.Settings.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Settings.GetList returned error: %v", err)
+	}
+
+	want := &Settings{
+		Timezone:       "Australia/Sydney",
+		DateFormat:     DateFormatEuropean,
+		TimeFormat:     TimeFormat24Hour,
+		DefaultList:    "123456",
+		Language:       "en-AU",
+		DefaultDueDate: "today",
+		Pro:            "1",
+	}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("Settings.GetList returned %+v, want %+v", settings, want)
+	}
+	if !settings.IsPro() {
+		t.Error("Settings.IsPro returned false, want true")
+	}
+
+	loc, err := settings.Location()
+	if err != nil {
+		t.Fatalf("Settings.Location returned error: %v", err)
+	}
+	if got, want := loc.String(), "Australia/Sydney"; got != want {
+		t.Errorf("Settings.Location returned %v, want %v", got, want)
+	}
+}
+
+func TestSettings_Location_empty(t *testing.T) {
+	if loc, err := (Settings{}).Location(); err == nil {
+		t.Errorf("Settings.Location returned %v, want error", loc)
+	}
+}
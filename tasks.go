@@ -2,9 +2,15 @@ package rememberthemilk
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
 )
 
-// TagService handles communication with the tasks related
+// TaskService handles communication with the tasks related
 // methods of the Remember The Milk API.
 //
 // Remember The Milk API docs: https://www.rememberthemilk.com/services/api/tasks.rtm
@@ -15,6 +21,20 @@ const (
 	ParseWithSmartAdd    = 1
 )
 
+// Priorities of a task. See TaskService.SetPriority.
+const (
+	PriorityHigh   = "1"
+	PriorityMedium = "2"
+	PriorityLow    = "3"
+	PriorityNone   = "N"
+)
+
+// Directions to move the priority of a task. See TaskService.MovePriority.
+const (
+	MovePriorityUp   = "up"
+	MovePriorityDown = "down"
+)
+
 type TaskInput struct {
 	Timeline   string `url:"timeline,omitempty"`
 	ListID     string `url:"list_id,omitempty"`
@@ -51,17 +71,98 @@ type Taskseries struct {
 	LocationID   string `json:"location_id"`
 	ParentTaskID string `json:"parent_task_id"`
 
-	// TODO Missing fields
-	// - tags
-	// - notes
-	// - participants
+	Tags         []string  `json:"tags"`
+	Notes        []Note    `json:"notes"`
+	Participants []Contact `json:"participants"`
+
+	// Recurrence is nil if the taskseries does not repeat.
+	Recurrence *RRule `json:"rrule,omitempty"`
 
 	Task []Task `json:"task"`
 }
 
+// UnmarshalJSON decodes a taskseries and unwraps the tags, notes and
+// participants collections. See unmarshalCollection for the format.
+func (t *Taskseries) UnmarshalJSON(data []byte) error {
+	type taskseries Taskseries
+	aux := struct {
+		*taskseries
+		Tags         json.RawMessage `json:"tags"`
+		Notes        json.RawMessage `json:"notes"`
+		Participants json.RawMessage `json:"participants"`
+	}{
+		taskseries: (*taskseries)(t),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if t.Tags, err = unmarshalCollection[string](aux.Tags, "tag"); err != nil {
+		return err
+	}
+	if t.Notes, err = unmarshalCollection[Note](aux.Notes, "note"); err != nil {
+		return err
+	}
+	if t.Participants, err = unmarshalCollection[Contact](aux.Participants, "contact"); err != nil {
+		return err
+	}
+	return nil
+}
+
 type TaskList struct {
 	ID         string       `json:"id"`
 	Taskseries []Taskseries `json:"taskseries"`
+
+	// Deleted holds the taskseries deleted since TaskGetListOptions.LastSync.
+	// Their tasks only carry the ID and the time of deletion.
+	Deleted []Taskseries `json:"deleted"`
+}
+
+// UnmarshalJSON decodes a list and unwraps the deleted taskseries.
+// See unmarshalCollection for the format.
+func (l *TaskList) UnmarshalJSON(data []byte) error {
+	type taskList TaskList
+	aux := struct {
+		*taskList
+		Deleted json.RawMessage `json:"deleted"`
+	}{
+		taskList: (*taskList)(l),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	l.Deleted, err = unmarshalCollection[Taskseries](aux.Deleted, "taskseries")
+	return err
+}
+
+// TaskGetListOptions specifies the optional parameters to the TaskService.GetList method.
+type TaskGetListOptions struct {
+	// ListID limits the result to the tasks of a single list.
+	// If omitted, tasks of all lists are returned.
+	ListID string `url:"list_id,omitempty"`
+
+	// Filter is a search query in the Remember The Milk search syntax, e.g. "status:incomplete AND dueBefore:tomorrow".
+	// See https://www.rememberthemilk.com/help/?ctx=basics.search.advanced
+	Filter string `url:"filter,omitempty"`
+
+	// LastSync limits the result to tasks modified since the given point in time.
+	LastSync time.Time `url:"last_sync,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+type TasksGetListResponse struct {
+	Tasks TaskListList `json:"tasks"`
+
+	BaseResponse
+}
+
+type TaskListList struct {
+	Rev  string     `json:"rev"`
+	List []TaskList `json:"list"`
 }
 
 type TaskAddResponse struct {
@@ -104,3 +205,395 @@ func (s *TaskService) Add(ctx context.Context, task TaskInput) (*TaskAddResponse
 
 	return &apiResponse.Response.TaskAddResponse, resp, nil
 }
+
+// GetList retrieves a list of tasks, grouped by the list they belong to.
+// If opts.ListID is omitted, tasks of all lists are returned.
+// If opts.LastSync is provided, only tasks modified since then are returned.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.getList.rtm
+func (s *TaskService) GetList(ctx context.Context, opts TaskGetListOptions) ([]TaskList, *Response, error) {
+	opts.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.getList")
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response struct {
+			TasksGetListResponse
+		} `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return apiResponse.Response.Tasks.List, resp, nil
+}
+
+// TaskModifyInput identifies the task a modifying method of the TaskService operates on.
+type TaskModifyInput struct {
+	Timeline     string `url:"timeline,omitempty"`
+	ListID       string `url:"list_id,omitempty"`
+	TaskseriesID string `url:"taskseries_id,omitempty"`
+	TaskID       string `url:"task_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+type TaskModifyResponse struct {
+	Transaction Transaction `json:"transaction"`
+	List        TaskList    `json:"list"`
+
+	BaseResponse
+}
+
+// Complete marks a task as complete.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.complete.rtm
+func (s *TaskService) Complete(ctx context.Context, task TaskModifyInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.complete")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// Uncomplete marks a task as incomplete.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.uncomplete.rtm
+func (s *TaskService) Uncomplete(ctx context.Context, task TaskModifyInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.uncomplete")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// Delete marks a task as deleted.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.delete.rtm
+func (s *TaskService) Delete(ctx context.Context, task TaskModifyInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.delete")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// Postpone postpones a task. If the task has no due date or is overdue,
+// its due date is set to today. Otherwise, the task due date is advanced a day.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.postpone.rtm
+func (s *TaskService) Postpone(ctx context.Context, task TaskModifyInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.postpone")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// TaskDate represents a due or start date of a task.
+//
+// Either Time or Text is sent to the API. Text takes precedence and is handed to
+// the natural language date parser of Remember The Milk, e.g. "next friday 3pm".
+// If HasTime is false, only the date of Time in the timezone of the user is used, so Time
+// should be midnight in that timezone, e.g. as returned by ParsedTime.TaskDate.
+// Time is always sent as UTC, so that the date is not shifted for users outside of UTC.
+// A TaskDate without Text and with a zero Time clears the date, regardless of HasTime.
+type TaskDate struct {
+	Time    time.Time
+	HasTime bool
+	Text    string
+}
+
+// EncodeValues implements the query.Encoder interface.
+// Next to key itself, it sets the parse and has_<key>_time parameters of the API.
+func (d TaskDate) EncodeValues(key string, v *url.Values) error {
+	switch {
+	case d.Text != "":
+		v.Set(key, d.Text)
+		v.Set("parse", "1")
+	case d.Time.IsZero():
+		// Omitting the date clears it.
+	case d.HasTime:
+		v.Set(key, d.Time.UTC().Format(time.RFC3339))
+		v.Set("has_"+key+"_time", "1")
+	default:
+		v.Set(key, d.Time.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+type TaskSetDueDateInput struct {
+	TaskModifyInput
+
+	Due TaskDate `url:"due"`
+}
+
+type TaskSetStartDateInput struct {
+	TaskModifyInput
+
+	Start TaskDate `url:"start"`
+}
+
+type TaskSetPriorityInput struct {
+	TaskModifyInput
+
+	// Priority is one of the Priority* constants.
+	Priority string `url:"priority,omitempty"`
+}
+
+type TaskMovePriorityInput struct {
+	TaskModifyInput
+
+	// Direction is one of the MovePriority* constants.
+	Direction string `url:"direction"`
+}
+
+type TaskSetEstimateInput struct {
+	TaskModifyInput
+
+	// Estimate is a time estimate in natural language, e.g. "1 hour 30 minutes".
+	Estimate string `url:"estimate,omitempty"`
+}
+
+type TaskSetNameInput struct {
+	TaskModifyInput
+
+	Name string `url:"name"`
+}
+
+type TaskSetURLInput struct {
+	TaskModifyInput
+
+	URL string `url:"url,omitempty"`
+}
+
+type TaskSetLocationInput struct {
+	TaskModifyInput
+
+	LocationID string `url:"location_id,omitempty"`
+}
+
+type TaskSetRecurrenceInput struct {
+	TaskModifyInput
+
+	// Repeat is the recurrence pattern in natural language, e.g. "every monday" or "after 2 weeks".
+	// If both Repeat and Rule are empty, the recurrence is cleared.
+	Repeat string `url:"repeat,omitempty"`
+
+	// Rule is the recurrence pattern as RRule, e.g. Taskseries.Recurrence of another taskseries.
+	// It is sent in its natural language form, see RRule.Repeat. Rule and Repeat are mutually exclusive.
+	Rule *RRule `url:"-"`
+}
+
+type TaskMoveToInput struct {
+	Timeline     string `url:"timeline,omitempty"`
+	FromListID   string `url:"from_list_id,omitempty"`
+	ToListID     string `url:"to_list_id,omitempty"`
+	TaskseriesID string `url:"taskseries_id,omitempty"`
+	TaskID       string `url:"task_id,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+type TaskSetParentTaskInput struct {
+	TaskModifyInput
+
+	// ParentTaskID is the ID of the task which becomes the parent.
+	// If empty, the task is turned into a top-level task.
+	ParentTaskID string `url:"parent_task_id,omitempty"`
+}
+
+type TaskTagsInput struct {
+	TaskModifyInput
+
+	// Tags are sent as a comma separated list.
+	// Surrounding whitespace is trimmed and empty tags are skipped.
+	Tags []string `url:"tags,comma,omitempty"`
+}
+
+// SetDueDate sets the due date of a task. If TaskSetDueDateInput.Due is zero, the due date is cleared.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setDueDate.rtm
+func (s *TaskService) SetDueDate(ctx context.Context, task TaskSetDueDateInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setDueDate")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// SetStartDate sets the start date of a task. If TaskSetStartDateInput.Start is zero, the start date is cleared.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setStartDate.rtm
+func (s *TaskService) SetStartDate(ctx context.Context, task TaskSetStartDateInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setStartDate")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// SetPriority sets the priority of a task. If TaskSetPriorityInput.Priority is empty, the priority is cleared.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setPriority.rtm
+func (s *TaskService) SetPriority(ctx context.Context, task TaskSetPriorityInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setPriority")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// MovePriority moves the priority of a task up or down.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.movePriority.rtm
+func (s *TaskService) MovePriority(ctx context.Context, task TaskMovePriorityInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.movePriority")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// SetEstimate sets the time estimate of a task. If TaskSetEstimateInput.Estimate is empty, the estimate is cleared.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setEstimate.rtm
+func (s *TaskService) SetEstimate(ctx context.Context, task TaskSetEstimateInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setEstimate")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// SetName renames a task.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setName.rtm
+func (s *TaskService) SetName(ctx context.Context, task TaskSetNameInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setName")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// SetURL sets the URL of a task. If TaskSetURLInput.URL is empty, the URL is cleared.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setURL.rtm
+func (s *TaskService) SetURL(ctx context.Context, task TaskSetURLInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setURL")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// SetLocation sets the location of a task. If TaskSetLocationInput.LocationID is empty, the location is cleared.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setLocation.rtm
+func (s *TaskService) SetLocation(ctx context.Context, task TaskSetLocationInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setLocation")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// AddTags adds tags to a task.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.addTags.rtm
+func (s *TaskService) AddTags(ctx context.Context, task TaskTagsInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.addTags")
+	return s.modifyTags(ctx, task)
+}
+
+// RemoveTags removes tags from a task.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.removeTags.rtm
+func (s *TaskService) RemoveTags(ctx context.Context, task TaskTagsInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.removeTags")
+	return s.modifyTags(ctx, task)
+}
+
+// SetTags replaces the tags of a task. If TaskTagsInput.Tags is empty, all tags are removed.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setTags.rtm
+func (s *TaskService) SetTags(ctx context.Context, task TaskTagsInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setTags")
+	return s.modifyTags(ctx, task)
+}
+
+func (s *TaskService) modifyTags(ctx context.Context, task TaskTagsInput) (*TaskModifyResponse, *Response, error) {
+	tags, err := normalizeTags(task.Tags)
+	if err != nil {
+		return nil, nil, err
+	}
+	task.Tags = tags
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// normalizeTags trims the whitespace around tags and drops empty ones.
+// The API separates tags by commas and offers no way to escape them,
+// so a tag containing a comma is rejected.
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if strings.Contains(tag, ",") {
+			return nil, fmt.Errorf("tag %q must not contain a comma", tag)
+		}
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
+
+// SetRecurrence sets the recurrence pattern of a task.
+// If TaskSetRecurrenceInput.Repeat and TaskSetRecurrenceInput.Rule are empty, the recurrence is cleared.
+// It returns an error if TaskSetRecurrenceInput.Rule cannot be expressed in natural language, see RRule.Repeat.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setRecurrence.rtm
+func (s *TaskService) SetRecurrence(ctx context.Context, task TaskSetRecurrenceInput) (*TaskModifyResponse, *Response, error) {
+	if task.Rule != nil {
+		if task.Repeat != "" {
+			return nil, nil, errors.New("either Repeat or Rule can be set, not both")
+		}
+		repeat, err := task.Rule.Repeat()
+		if err != nil {
+			return nil, nil, err
+		}
+		task.Repeat = repeat
+	}
+
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setRecurrence")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// MoveTo moves a task from the list TaskMoveToInput.FromListID to the list TaskMoveToInput.ToListID.
+// The returned TaskModifyResponse.List is the destination list.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.moveTo.rtm
+func (s *TaskService) MoveTo(ctx context.Context, task TaskMoveToInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.moveTo")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
+
+// SetParentTask turns a task into a sub-task of TaskSetParentTaskInput.ParentTaskID.
+// If TaskSetParentTaskInput.ParentTaskID is empty, the task is turned into a top-level task.
+// Sub-tasks require a Pro account.
+//
+// This method requires a timeline.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.tasks.setParentTask.rtm
+func (s *TaskService) SetParentTask(ctx context.Context, task TaskSetParentTaskInput) (*TaskModifyResponse, *Response, error) {
+	task.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.tasks.setParentTask")
+	return doAPI[TaskModifyResponse](ctx, s.client, task)
+}
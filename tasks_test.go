@@ -0,0 +1,395 @@
+package rememberthemilk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTaskService_GetList(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.getList")
+		testParam(t, r, "list_id", "100653")
+		testParam(t, r, "filter", "status:incomplete")
+		testParam(t, r, "last_sync", "2025-01-02T03:04:05Z")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","tasks":{"rev":"abc","list":[{"id":"100653","taskseries":[
+			{"id":"117192","created":"2025-01-01T10:00:00Z","modified":"2025-01-02T10:00:00Z","name":"Get Bananas","source":"api","url":"","location_id":"","parent_task_id":"",
+			 "task":[{"id":"216859","due":"","has_due_time":"0","added":"2025-01-01T10:00:00Z","completed":"","deleted":"","priority":"N","postponed":"0","estimate":"","start":"","has_start_time":"0"}]}
+		]}]}}}`)
+	})
+
+	opts := TaskGetListOptions{
+		ListID:   "100653",
+		Filter:   "status:incomplete",
+		LastSync: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	lists, _, err := client.Tasks.GetList(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Tasks.GetList returned error: %v", err)
+	}
+
+	if len(lists) != 1 || lists[0].ID != "100653" {
+		t.Fatalf("Tasks.GetList returned %+v, want a single list 100653", lists)
+	}
+	series := lists[0].Taskseries
+	if len(series) != 1 || series[0].Name != "Get Bananas" {
+		t.Fatalf("Tasks.GetList returned taskseries %+v, want a single taskseries \"Get Bananas\"", series)
+	}
+	if len(series[0].Task) != 1 || series[0].Task[0].ID != "216859" {
+		t.Errorf("Tasks.GetList returned tasks %+v, want a single task 216859", series[0].Task)
+	}
+}
+
+func TestTaskService_GetList_deleted(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.getList")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","tasks":{"rev":"abc","list":[{"id":"100653",
+			"deleted":{"taskseries":[{"id":"117193","task":[{"id":"216860","deleted":"2025-01-02T10:00:00Z"}]}]}}]}}}`)
+	})
+
+	opts := TaskGetListOptions{
+		LastSync: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	lists, _, err := client.Tasks.GetList(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Tasks.GetList returned error: %v", err)
+	}
+
+	if len(lists) != 1 || len(lists[0].Taskseries) != 0 {
+		t.Fatalf("Tasks.GetList returned %+v, want a single list without taskseries", lists)
+	}
+	deleted := lists[0].Deleted
+	if len(deleted) != 1 || deleted[0].ID != "117193" {
+		t.Fatalf("Tasks.GetList returned deleted taskseries %+v, want a single taskseries 117193", deleted)
+	}
+	if len(deleted[0].Task) != 1 || deleted[0].Task[0].Deleted != "2025-01-02T10:00:00Z" {
+		t.Errorf("Tasks.GetList returned deleted tasks %+v, want a single task deleted at 2025-01-02T10:00:00Z", deleted[0].Task)
+	}
+}
+
+func TestTaskseries_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name             string
+		data             string
+		wantTags         []string
+		wantNotes        int
+		wantParticipants int
+		wantRecurrence   *RRule
+	}{
+		{
+			name: "empty collections",
+			data: `{"id":"1","tags":[],"notes":[],"participants":[],"task":[]}`,
+		},
+		{
+			name: "populated collections",
+			data: `{"id":"1",
+				"tags":{"tag":["bananas","shopping"]},
+				"notes":{"note":[{"id":"169624","created":"2025-01-01T10:00:00Z","modified":"2025-01-01T10:00:00Z","title":"Note","$t":"Body"}]},
+				"participants":{"contact":[{"id":"1","fullname":"Bob T. Monkey","username":"bob"},{"id":"2","fullname":"Omar Kilani","username":"omar"}]},
+				"rrule":{"every":"1","$t":"FREQ=WEEKLY;INTERVAL=1"},
+				"task":[]}`,
+			wantTags:         []string{"bananas", "shopping"},
+			wantNotes:        1,
+			wantParticipants: 2,
+			wantRecurrence:   &RRule{Rule: "FREQ=WEEKLY;INTERVAL=1", Every: true, Freq: FreqWeekly, Interval: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ts Taskseries
+			if err := json.Unmarshal([]byte(tt.data), &ts); err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+
+			if !reflect.DeepEqual(ts.Tags, tt.wantTags) {
+				t.Errorf("Tags = %v, want %v", ts.Tags, tt.wantTags)
+			}
+			if len(ts.Notes) != tt.wantNotes {
+				t.Errorf("len(Notes) = %d, want %d", len(ts.Notes), tt.wantNotes)
+			}
+			if tt.wantNotes > 0 && ts.Notes[0].Body != "Body" {
+				t.Errorf("Notes[0].Body = %q, want %q", ts.Notes[0].Body, "Body")
+			}
+			if len(ts.Participants) != tt.wantParticipants {
+				t.Errorf("len(Participants) = %d, want %d", len(ts.Participants), tt.wantParticipants)
+			}
+			if !reflect.DeepEqual(ts.Recurrence, tt.wantRecurrence) {
+				t.Errorf("Recurrence = %+v, want %+v", ts.Recurrence, tt.wantRecurrence)
+			}
+			if ts.ID != "1" {
+				t.Errorf("ID = %q, want %q", ts.ID, "1")
+			}
+		})
+	}
+}
+
+func TestTaskService_Complete(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.complete")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "list_id", "100653")
+		testParam(t, r, "taskseries_id", "117192")
+		testParam(t, r, "task_id", "216859")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"100653","taskseries":[
+			{"id":"117192","name":"Get Bananas","tags":[],"notes":[],"participants":[],"task":[{"id":"216859","completed":"2025-01-02T10:00:00Z"}]}
+		]}}}`)
+	})
+
+	input := TaskModifyInput{
+		Timeline:     "12741021",
+		ListID:       "100653",
+		TaskseriesID: "117192",
+		TaskID:       "216859",
+	}
+	result, _, err := client.Tasks.Complete(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Tasks.Complete returned error: %v", err)
+	}
+
+	if result.Transaction.ID != "4711" {
+		t.Errorf("Tasks.Complete returned transaction %q, want %q", result.Transaction.ID, "4711")
+	}
+	if got := result.List.Taskseries[0].Task[0].Completed; got != "2025-01-02T10:00:00Z" {
+		t.Errorf("Tasks.Complete returned completed %q, want %q", got, "2025-01-02T10:00:00Z")
+	}
+}
+
+func TestTaskDate_EncodeValues(t *testing.T) {
+	tests := []struct {
+		name string
+		date TaskDate
+		want url.Values
+	}{
+		{
+			name: "zero clears the date",
+			date: TaskDate{},
+			want: url.Values{},
+		},
+		{
+			name: "zero time with time flag clears the date",
+			date: TaskDate{HasTime: true},
+			want: url.Values{},
+		},
+		{
+			name: "date only",
+			date: TaskDate{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600))},
+			want: url.Values{"due": {"2025-02-28T23:00:00Z"}},
+		},
+		{
+			name: "date with time",
+			date: TaskDate{Time: time.Date(2025, 3, 1, 15, 0, 0, 0, time.FixedZone("CET", 3600)), HasTime: true},
+			want: url.Values{"due": {"2025-03-01T14:00:00Z"}, "has_due_time": {"1"}},
+		},
+		{
+			name: "natural language",
+			date: TaskDate{Text: "next friday 3pm"},
+			want: url.Values{"due": {"next friday 3pm"}, "parse": {"1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := url.Values{}
+			if err := tt.date.EncodeValues("due", &got); err != nil {
+				t.Fatalf("EncodeValues returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EncodeValues = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskService_AddTags(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.addTags")
+		testParam(t, r, "tags", "ci,build failed,nightly")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"100653","taskseries":[
+			{"id":"117192","tags":{"tag":["build failed","ci","nightly"]},"notes":[],"participants":[],"task":[]}
+		]}}}`)
+	})
+
+	input := TaskTagsInput{
+		TaskModifyInput: TaskModifyInput{Timeline: "12741021", ListID: "100653", TaskseriesID: "117192", TaskID: "216859"},
+		Tags:            []string{"ci", " build failed ", "", "nightly"},
+	}
+	result, _, err := client.Tasks.AddTags(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Tasks.AddTags returned error: %v", err)
+	}
+
+	want := []string{"build failed", "ci", "nightly"}
+	if got := result.List.Taskseries[0].Tags; !reflect.DeepEqual(got, want) {
+		t.Errorf("Tasks.AddTags returned tags %v, want %v", got, want)
+	}
+}
+
+func TestTaskService_AddTags_comma(t *testing.T) {
+	client, _ := setup(t)
+
+	input := TaskTagsInput{Tags: []string{"a,b"}}
+	if _, _, err := client.Tasks.AddTags(context.Background(), input); err == nil {
+		t.Error("Tasks.AddTags returned no error for a tag containing a comma")
+	}
+}
+
+func TestTaskService_MoveTo(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.moveTo")
+		testParam(t, r, "from_list_id", "100653")
+		testParam(t, r, "to_list_id", "100654")
+		testParam(t, r, "list_id", "")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"100654","taskseries":[]}}}`)
+	})
+
+	input := TaskMoveToInput{
+		Timeline:     "12741021",
+		FromListID:   "100653",
+		ToListID:     "100654",
+		TaskseriesID: "117192",
+		TaskID:       "216859",
+	}
+	result, _, err := client.Tasks.MoveTo(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Tasks.MoveTo returned error: %v", err)
+	}
+
+	if result.List.ID != "100654" {
+		t.Errorf("Tasks.MoveTo returned list %q, want %q", result.List.ID, "100654")
+	}
+}
+
+func TestTaskService_SetRecurrence(t *testing.T) {
+	client, mux := setup(t)
+
+	rule := &RRule{Every: true, Freq: FreqWeekly, Interval: 2}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.setRecurrence")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "task_id", "216859")
+		testParam(t, r, "repeat", "every 2 weeks")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"100653","taskseries":[
+			{"id":"117192","name":"Standup","rrule":{"every":"1","$t":"FREQ=WEEKLY;INTERVAL=2"},"tags":[],"notes":[],"participants":[],"task":[{"id":"216859"}]}
+		]}}}`)
+	})
+
+	input := TaskSetRecurrenceInput{
+		TaskModifyInput: TaskModifyInput{
+			Timeline:     "12741021",
+			ListID:       "100653",
+			TaskseriesID: "117192",
+			TaskID:       "216859",
+		},
+		Rule: rule,
+	}
+	result, _, err := client.Tasks.SetRecurrence(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Tasks.SetRecurrence returned error: %v", err)
+	}
+
+	got := result.List.Taskseries[0].Recurrence
+	if got == nil || !got.Every || got.String() != rule.String() {
+		t.Errorf("Tasks.SetRecurrence returned recurrence %+v, want every %q", got, rule.String())
+	}
+}
+
+func TestTaskService_SetRecurrence_invalidRule(t *testing.T) {
+	client, _ := setup(t)
+
+	input := TaskSetRecurrenceInput{
+		TaskModifyInput: TaskModifyInput{Timeline: "12741021", TaskID: "216859"},
+		Rule:            &RRule{Every: true, Freq: FreqMonthly, Other: []string{"BYMONTHDAY=15"}},
+	}
+	if _, _, err := client.Tasks.SetRecurrence(context.Background(), input); err == nil {
+		t.Error("Tasks.SetRecurrence returned no error for a rule without natural language form")
+	}
+}
+
+func TestTaskService_SetDueDate(t *testing.T) {
+	tests := []struct {
+		name string
+		due  TaskDate
+		want url.Values
+	}{
+		{
+			name: "date with time",
+			due:  TaskDate{Time: time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC), HasTime: true},
+			want: url.Values{"due": {"2025-03-01T15:00:00Z"}, "has_due_time": {"1"}},
+		},
+		{
+			name: "natural language",
+			due:  TaskDate{Text: "tomorrow"},
+			want: url.Values{"due": {"tomorrow"}, "parse": {"1"}},
+		},
+		{
+			name: "clear",
+			due:  TaskDate{HasTime: true},
+			want: url.Values{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "rtm.tasks.setDueDate")
+				testParam(t, r, "task_id", "216859")
+				for _, key := range []string{"due", "has_due_time", "parse"} {
+					testParam(t, r, key, tt.want.Get(key))
+				}
+				fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"100653","taskseries":[]}}}`)
+			})
+
+			input := TaskSetDueDateInput{
+				TaskModifyInput: TaskModifyInput{Timeline: "12741021", ListID: "100653", TaskseriesID: "117192", TaskID: "216859"},
+				Due:             tt.due,
+			}
+			if _, _, err := client.Tasks.SetDueDate(context.Background(), input); err != nil {
+				t.Fatalf("Tasks.SetDueDate returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestTaskService_MovePriority(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.tasks.movePriority")
+		testParam(t, r, "timeline", "12741021")
+		testParam(t, r, "task_id", "216859")
+		testParam(t, r, "direction", MovePriorityUp)
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"100653","taskseries":[
+			{"id":"117192","name":"Get Bananas","tags":[],"notes":[],"participants":[],"task":[{"id":"216859","priority":"1"}]}
+		]}}}`)
+	})
+
+	input := TaskMovePriorityInput{
+		TaskModifyInput: TaskModifyInput{Timeline: "12741021", ListID: "100653", TaskseriesID: "117192", TaskID: "216859"},
+		Direction:       MovePriorityUp,
+	}
+	result, _, err := client.Tasks.MovePriority(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Tasks.MovePriority returned error: %v", err)
+	}
+	if got := result.List.Taskseries[0].Task[0].Priority; got != PriorityHigh {
+		t.Errorf("Tasks.MovePriority returned priority %q, want %q", got, PriorityHigh)
+	}
+}
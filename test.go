@@ -2,6 +2,8 @@ package rememberthemilk
 
 import (
 	"context"
+	"encoding/json"
+	"net/url"
 )
 
 // TestService handles communication with the test related
@@ -21,6 +23,67 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// TestEchoOptions specifies the parameters to the TestService.Echo method.
+type TestEchoOptions struct {
+	Params echoParams `url:"params"`
+
+	BaseAPIURLOptions
+}
+
+// echoParams are the arbitrary parameters sent to rtm.test.echo.
+type echoParams map[string]string
+
+// EncodeValues implements the query.Encoder interface.
+// Parameters which collide with BaseAPIURLOptions are skipped.
+func (p echoParams) EncodeValues(_ string, v *url.Values) error {
+	for key, value := range p {
+		switch key {
+		case "method", "api_key", "auth_token", "format", "v", "api_sig":
+			continue
+		}
+		v.Set(key, value)
+	}
+	return nil
+}
+
+// Echo represents a testing method which echoes all parameters back in the response.
+// The returned map contains params as well as the parameters added by the client, like "method".
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.test.echo.rtm
+func (s *TestService) Echo(ctx context.Context, params map[string]string) (map[string]string, *Response, error) {
+	opts := &TestEchoOptions{
+		Params:            params,
+		BaseAPIURLOptions: s.client.addBaseAPIURLOptions("rtm.test.echo"),
+	}
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response map[string]json.RawMessage `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	echoed := make(map[string]string, len(apiResponse.Response))
+	for key, raw := range apiResponse.Response {
+		var value string
+		if key == "stat" || json.Unmarshal(raw, &value) != nil {
+			continue
+		}
+		echoed[key] = value
+	}
+	return echoed, resp, nil
+}
+
 // Login represents a testing method which checks if the caller is logged in.
 //
 // Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.test.login.rtm
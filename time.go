@@ -0,0 +1,125 @@
+package rememberthemilk
+
+import (
+	"context"
+	"time"
+)
+
+// TimeService handles communication with the time related
+// methods of the Remember The Milk API.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods.rtm
+type TimeService service
+
+// Precisions of a parsed time. See ParsedTime.Precision.
+const (
+	PrecisionDate = "date"
+	PrecisionTime = "time"
+)
+
+// rtmLocalTimeLayout is the layout of times without timezone information, as returned by rtm.time.convert.
+const rtmLocalTimeLayout = "2006-01-02T15:04:05"
+
+type TimeParseInput struct {
+	// Text is the time in natural language, e.g. "next friday 3pm".
+	Text string `url:"text"`
+
+	// Timezone is the IANA name of the timezone Text is interpreted in.
+	// If omitted, the timezone of the user is used.
+	Timezone string `url:"timezone,omitempty"`
+
+	// DateFormat is one of the DateFormat* constants and
+	// decides how ambiguous dates like 02/03 are interpreted.
+	DateFormat string `url:"dateformat,omitempty"`
+
+	BaseAPIURLOptions
+}
+
+type TimeConvertInput struct {
+	// ToTimezone is the IANA name of the target timezone.
+	ToTimezone string `url:"to_timezone"`
+
+	// FromTimezone is the IANA name of the timezone of Time.
+	// If omitted, UTC is used.
+	FromTimezone string `url:"from_timezone,omitempty"`
+
+	// Time is the wall clock time in FromTimezone to convert.
+	// Its own location is ignored. If omitted, the current time is used.
+	Time time.Time `url:"time,omitempty" layout:"2006-01-02T15:04:05"`
+
+	BaseAPIURLOptions
+}
+
+type TimeResponse struct {
+	Time struct {
+		Precision string `json:"precision"`
+		Timezone  string `json:"timezone"`
+		Value     string `json:"$t"`
+	} `json:"time"`
+
+	BaseResponse
+}
+
+// ParsedTime represents a time parsed by Remember The Milk.
+type ParsedTime struct {
+	Time time.Time
+
+	// Precision is one of the Precision* constants.
+	Precision string
+}
+
+// TaskDate returns the parsed time as due or start date of a task.
+// The time of day is only used if it was part of the parsed text.
+func (t ParsedTime) TaskDate() TaskDate {
+	return TaskDate{
+		Time:    t.Time,
+		HasTime: t.Precision == PrecisionTime,
+	}
+}
+
+// Parse parses a time in natural language, e.g. "next friday 3pm", the way Remember The Milk does.
+// The returned time is in UTC.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.time.parse.rtm
+func (s *TimeService) Parse(ctx context.Context, input TimeParseInput) (*ParsedTime, *Response, error) {
+	input.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.time.parse")
+	apiResponse, resp, err := doAPI[TimeResponse](ctx, s.client, input)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	t, err := time.Parse(time.RFC3339, apiResponse.Time.Value)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &ParsedTime{Time: t, Precision: apiResponse.Time.Precision}, resp, nil
+}
+
+// Convert converts a time from one timezone to another.
+// The returned time is in the location of TimeConvertInput.ToTimezone.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.time.convert.rtm
+func (s *TimeService) Convert(ctx context.Context, input TimeConvertInput) (time.Time, *Response, error) {
+	input.BaseAPIURLOptions = s.client.addBaseAPIURLOptions("rtm.time.convert")
+	apiResponse, resp, err := doAPI[TimeResponse](ctx, s.client, input)
+	if err != nil {
+		return time.Time{}, resp, err
+	}
+
+	timezone := apiResponse.Time.Timezone
+	if timezone == "" {
+		timezone = input.ToTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, resp, err
+	}
+
+	t, err := time.ParseInLocation(rtmLocalTimeLayout, apiResponse.Time.Value, loc)
+	if err != nil {
+		return time.Time{}, resp, err
+	}
+
+	return t, resp, nil
+}
@@ -0,0 +1,88 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimeService_Parse(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.time.parse")
+		testParam(t, r, "text", "next friday 3pm")
+		testParam(t, r, "timezone", "Europe/Berlin")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","time":{"precision":"time","$t":"2025-03-07T14:00:00Z"}}}`)
+	})
+
+	input := TimeParseInput{Text: "next friday 3pm", Timezone: "Europe/Berlin"}
+	parsed, _, err := client.Time.Parse(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Time.Parse returned error: %v", err)
+	}
+
+	want := TaskDate{Time: time.Date(2025, 3, 7, 14, 0, 0, 0, time.UTC), HasTime: true}
+	if got := parsed.TaskDate(); !got.Time.Equal(want.Time) || got.HasTime != want.HasTime {
+		t.Errorf("Time.Parse returned %+v, want %+v", got, want)
+	}
+}
+
+func TestTimeService_Parse_date(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.time.parse")
+		testParam(t, r, "text", "tomorrow")
+		testParam(t, r, "timezone", "Europe/Berlin")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","time":{"precision":"date","$t":"2025-03-06T23:00:00Z"}}}`)
+	})
+
+	input := TimeParseInput{Text: "tomorrow", Timezone: "Europe/Berlin"}
+	parsed, _, err := client.Time.Parse(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Time.Parse returned error: %v", err)
+	}
+
+	date := parsed.TaskDate()
+	if date.HasTime {
+		t.Errorf("Time.Parse returned %+v, want a date without time", date)
+	}
+
+	got := url.Values{}
+	if err := date.EncodeValues("due", &got); err != nil {
+		t.Fatalf("EncodeValues returned error: %v", err)
+	}
+	want := url.Values{"due": {"2025-03-06T23:00:00Z"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EncodeValues = %v, want %v", got, want)
+	}
+}
+
+func TestTimeService_Convert(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.time.convert")
+		testParam(t, r, "to_timezone", "Australia/Sydney")
+		testParam(t, r, "time", "2025-03-07T09:00:00")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","time":{"timezone":"Australia/Sydney","$t":"2025-03-07T20:00:00"}}}`)
+	})
+
+	input := TimeConvertInput{ToTimezone: "Australia/Sydney", Time: time.Date(2025, 3, 7, 9, 0, 0, 0, time.UTC)}
+	converted, _, err := client.Time.Convert(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Time.Convert returned error: %v", err)
+	}
+
+	if want := time.Date(2025, 3, 7, 9, 0, 0, 0, time.UTC); !converted.Equal(want) {
+		t.Errorf("Time.Convert returned %v, want %v", converted, want)
+	}
+	if converted.Location().String() != "Australia/Sydney" {
+		t.Errorf("Time.Convert returned location %v, want %v", converted.Location(), "Australia/Sydney")
+	}
+}
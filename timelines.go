@@ -21,6 +21,11 @@ type Transaction struct {
 	Undoable string `json:"undoable"`
 }
 
+// IsUndoable reports whether the transaction can be reverted by TransactionsService.Undo.
+func (t Transaction) IsUndoable() bool {
+	return t.Undoable == "1"
+}
+
 // Create returns a new timeline.
 //
 // Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.timelines.create.rtm
@@ -0,0 +1,59 @@
+package rememberthemilk
+
+import (
+	"context"
+)
+
+// TimezonesService handles communication with the timezone related
+// methods of the Remember The Milk API.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods.rtm
+type TimezonesService service
+
+type TimezonesGetListResponse struct {
+	Timezones TimezoneList `json:"timezones"`
+
+	BaseResponse
+}
+
+type TimezoneList struct {
+	Timezone []Timezone `json:"timezone"`
+}
+
+// Timezone represents a timezone known to Remember The Milk.
+// Offsets are in seconds from UTC.
+type Timezone struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	DST           string `json:"dst"`
+	Offset        string `json:"offset"`
+	CurrentOffset string `json:"current_offset"`
+}
+
+// GetList retrieves a list of timezones.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.timezones.getList.rtm
+func (s *TimezonesService) GetList(ctx context.Context) ([]Timezone, *Response, error) {
+	opts := s.client.addBaseAPIURLOptions("rtm.timezones.getList")
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var apiResponse struct {
+		Response struct {
+			TimezonesGetListResponse
+		} `json:"rsp"`
+	}
+	resp, err := s.client.Do(ctx, req, &apiResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return apiResponse.Response.Timezones.Timezone, resp, nil
+}
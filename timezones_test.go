@@ -0,0 +1,34 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTimezonesService_GetList(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.timezones.getList")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","timezones":{"timezone":[
+			{"id":"216","name":"Asia/Hong_Kong","dst":"0","offset":"28800","current_offset":"28800"},
+			{"id":"332","name":"Australia/Sydney","dst":"1","offset":"36000","current_offset":"39600"}
+		]}}}`)
+	})
+
+	timezones, _, err := client.Timezones.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Timezones.GetList returned error: %v", err)
+	}
+
+	want := []Timezone{
+		{ID: "216", Name: "Asia/Hong_Kong", DST: "0", Offset: "28800", CurrentOffset: "28800"},
+		{ID: "332", Name: "Australia/Sydney", DST: "1", Offset: "36000", CurrentOffset: "39600"},
+	}
+	if !reflect.DeepEqual(timezones, want) {
+		t.Errorf("Timezones.GetList returned %+v, want %+v", timezones, want)
+	}
+}
@@ -0,0 +1,223 @@
+package rememberthemilk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DefaultTokenEnvironmentVariable is the environment variable EnvTokenStore uses by default.
+const DefaultTokenEnvironmentVariable = "RTM_AUTH_TOKEN"
+
+// ErrTokenNotFound is returned by TokenStore.Load if no token has been saved.
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenStore persists the authentication of a Client.
+//
+// See Client.UseTokenStore.
+type TokenStore interface {
+	// Load returns the saved authentication or ErrTokenNotFound if there is none.
+	Load(ctx context.Context) (*Authentication, error)
+
+	// Save saves the authentication, replacing a previously saved one.
+	Save(ctx context.Context, auth *Authentication) error
+
+	// Delete removes the saved authentication. Deleting a missing authentication is not an error.
+	Delete(ctx context.Context) error
+}
+
+// UseTokenStore loads the authentication token from store and sets it on the client.
+// A store without a saved token is not an error.
+//
+// Afterwards, AuthenticationService.GetToken sets a newly retrieved token
+// on the client and saves it to store, and AuthenticationService.CheckToken
+// deletes the token from store if the API rejects it.
+func (c *Client) UseTokenStore(ctx context.Context, store TokenStore) error {
+	auth, err := store.Load(ctx)
+	switch {
+	case errors.Is(err, ErrTokenNotFound):
+	case err != nil:
+		return err
+	default:
+		c.SetAuthentication(auth)
+	}
+
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	c.tokenStore = store
+	return nil
+}
+
+// getTokenStore returns the TokenStore of the client or nil if there is none.
+func (c *Client) getTokenStore() TokenStore {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.tokenStore
+}
+
+// FileTokenStore stores the authentication as JSON file, readable only by the current user.
+type FileTokenStore struct {
+	// Path is the location of the JSON file.
+	Path string
+}
+
+// NewFileTokenStore returns a FileTokenStore for path.
+// If path is empty, DefaultTokenPath is used.
+func NewFileTokenStore(path string) (*FileTokenStore, error) {
+	if path == "" {
+		var err error
+		if path, err = DefaultTokenPath(); err != nil {
+			return nil, err
+		}
+	}
+	return &FileTokenStore{Path: path}, nil
+}
+
+// DefaultTokenPath returns the default location of the token file,
+// go-rememberthemilk/token.json in the user's configuration directory
+// ($XDG_CONFIG_HOME or ~/.config on Linux). See os.UserConfigDir.
+func DefaultTokenPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "go-rememberthemilk", "token.json"), nil
+}
+
+// Load implements the TokenStore interface.
+func (s *FileTokenStore) Load(_ context.Context) (*Authentication, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	auth := &Authentication{}
+	if err := json.Unmarshal(data, auth); err != nil {
+		return nil, err
+	}
+	if auth.Token == "" {
+		return nil, ErrTokenNotFound
+	}
+	return auth, nil
+}
+
+// Save implements the TokenStore interface.
+// The file is written with mode 0600, missing directories are created with mode 0700.
+func (s *FileTokenStore) Save(_ context.Context, auth *Authentication) error {
+	data, err := json.MarshalIndent(auth, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.Path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so a failed write does not destroy the saved token.
+	f, err := os.CreateTemp(dir, ".token-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := f.Chmod(0o600); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.Path)
+}
+
+// Delete implements the TokenStore interface.
+func (s *FileTokenStore) Delete(_ context.Context) error {
+	err := os.Remove(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// EnvTokenStore reads the authentication token from an environment variable.
+// The permission level of the token is unknown.
+//
+// Save and Delete only modify the environment of the current process.
+type EnvTokenStore struct {
+	// Name is the name of the environment variable.
+	// If empty, DefaultTokenEnvironmentVariable is used.
+	Name string
+}
+
+func (s *EnvTokenStore) name() string {
+	if s.Name == "" {
+		return DefaultTokenEnvironmentVariable
+	}
+	return s.Name
+}
+
+// Load implements the TokenStore interface.
+func (s *EnvTokenStore) Load(_ context.Context) (*Authentication, error) {
+	token := os.Getenv(s.name())
+	if token == "" {
+		return nil, ErrTokenNotFound
+	}
+	return &Authentication{Token: token}, nil
+}
+
+// Save implements the TokenStore interface.
+func (s *EnvTokenStore) Save(_ context.Context, auth *Authentication) error {
+	return os.Setenv(s.name(), auth.Token)
+}
+
+// Delete implements the TokenStore interface.
+func (s *EnvTokenStore) Delete(_ context.Context) error {
+	return os.Unsetenv(s.name())
+}
+
+// MemoryTokenStore keeps the authentication in memory.
+// It is safe for concurrent use.
+type MemoryTokenStore struct {
+	mu   sync.Mutex
+	auth *Authentication
+}
+
+// Load implements the TokenStore interface.
+func (s *MemoryTokenStore) Load(_ context.Context) (*Authentication, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.auth == nil {
+		return nil, ErrTokenNotFound
+	}
+	auth := *s.auth
+	return &auth, nil
+}
+
+// Save implements the TokenStore interface.
+func (s *MemoryTokenStore) Save(_ context.Context, auth *Authentication) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved := *auth
+	s.auth = &saved
+	return nil
+}
+
+// Delete implements the TokenStore interface.
+func (s *MemoryTokenStore) Delete(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.auth = nil
+	return nil
+}
@@ -0,0 +1,177 @@
+package rememberthemilk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTokenStores(t *testing.T) {
+	fileStore, err := NewFileTokenStore(filepath.Join(t.TempDir(), "config", "token.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("RTM_TEST_TOKEN", "")
+
+	stores := map[string]TokenStore{
+		"file":   fileStore,
+		"env":    &EnvTokenStore{Name: "RTM_TEST_TOKEN"},
+		"memory": &MemoryTokenStore{},
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if _, err := store.Load(ctx); !errors.Is(err, ErrTokenNotFound) {
+				t.Fatalf("Load returned error %v on an empty store, want %v", err, ErrTokenNotFound)
+			}
+
+			if err := store.Save(ctx, &Authentication{Token: "token", Permissions: PermissionWrite}); err != nil {
+				t.Fatalf("Save returned error: %v", err)
+			}
+			auth, err := store.Load(ctx)
+			if err != nil {
+				t.Fatalf("Load returned error: %v", err)
+			}
+			if auth.Token != "token" {
+				t.Errorf("Load returned token %q, want %q", auth.Token, "token")
+			}
+
+			if err := store.Delete(ctx); err != nil {
+				t.Fatalf("Delete returned error: %v", err)
+			}
+			if _, err := store.Load(ctx); !errors.Is(err, ErrTokenNotFound) {
+				t.Errorf("Load returned error %v after Delete, want %v", err, ErrTokenNotFound)
+			}
+			if err := store.Delete(ctx); err != nil {
+				t.Errorf("Delete of a missing token returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestFileTokenStore_permissions(t *testing.T) {
+	store := &FileTokenStore{Path: filepath.Join(t.TempDir(), "token.json")}
+	if err := store.Save(context.Background(), &Authentication{Token: "token"}); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	info, err := os.Stat(store.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o600 {
+		t.Errorf("Save created file with mode %v, want %v", mode, os.FileMode(0o600))
+	}
+}
+
+func TestClient_UseTokenStore(t *testing.T) {
+	client, mux := setup(t)
+	client.SetAuthenticationToken("")
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.auth.getToken")
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","auth":{"token":"new-token","perms":"delete","user":{"id":"1","username":"bob"}}}}`)
+	})
+
+	ctx := context.Background()
+	store := &MemoryTokenStore{}
+	if err := client.UseTokenStore(ctx, store); err != nil {
+		t.Fatalf("UseTokenStore returned error: %v", err)
+	}
+
+	if _, _, err := client.Authentication.GetToken(ctx, "frob"); err != nil {
+		t.Fatalf("Authentication.GetToken returned error: %v", err)
+	}
+
+	saved, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if saved.Token != "new-token" || saved.Permissions != PermissionDelete {
+		t.Errorf("GetToken saved %+v, want token %q with %q permission", saved, "new-token", PermissionDelete)
+	}
+
+	other := NewClient("api-key", "shared-secret", "", nil)
+	if err := other.UseTokenStore(ctx, store); err != nil {
+		t.Fatalf("UseTokenStore returned error: %v", err)
+	}
+	if other.authentication() != "new-token" || other.Permissions() != PermissionDelete {
+		t.Errorf("UseTokenStore loaded token %q with %q permission, want %q with %q permission", other.authentication(), other.Permissions(), "new-token", PermissionDelete)
+	}
+}
+
+func TestClient_UseTokenStore_rejectedToken(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "rtm.auth.checkToken")
+		testParam(t, r, "auth_token", "stale-token")
+		fmt.Fprint(w, `{"rsp":{"stat":"fail","err":{"code":"98","msg":"Login failed / Invalid auth token"}}}`)
+	})
+
+	ctx := context.Background()
+	store := &MemoryTokenStore{}
+	if err := store.Save(ctx, &Authentication{Token: "stale-token", Permissions: PermissionRead}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.UseTokenStore(ctx, store); err != nil {
+		t.Fatalf("UseTokenStore returned error: %v", err)
+	}
+
+	if _, _, err := client.Authentication.CheckToken(ctx); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Authentication.CheckToken returned error %v, want %v", err, ErrInvalidToken)
+	}
+
+	if _, err := store.Load(ctx); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("Load returned error %v, want %v", err, ErrTokenNotFound)
+	}
+	if client.authentication() != "" || client.Permissions() != "" {
+		t.Errorf("Client kept token %q with %q permission, want none", client.authentication(), client.Permissions())
+	}
+}
+
+func TestClient_UseTokenStore_rejectedAdHocToken(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("method") {
+		case "rtm.auth.checkToken":
+			testParam(t, r, "auth_token", "ad-hoc-token")
+			fmt.Fprint(w, `{"rsp":{"stat":"fail","err":{"code":"98","msg":"Login failed / Invalid auth token"}}}`)
+		case "rtm.test.echo":
+			fmt.Fprintf(w, `{"rsp":{"stat":"ok","method":"rtm.test.echo","nonce":%q}}`, r.URL.Query().Get("nonce"))
+		}
+	})
+
+	ctx := context.Background()
+	store := &MemoryTokenStore{}
+	if err := store.Save(ctx, &Authentication{Token: "stored-token", Permissions: PermissionRead}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.UseTokenStore(ctx, store); err != nil {
+		t.Fatalf("UseTokenStore returned error: %v", err)
+	}
+	client.SetAuthenticationToken("ad-hoc-token")
+
+	if report := client.Diagnose(ctx); report.Problem != ProblemToken {
+		t.Fatalf("Diagnose returned problem %q, want %q", report.Problem, ProblemToken)
+	}
+	if got := client.authentication(); got != "ad-hoc-token" {
+		t.Errorf("Diagnose changed the token to %q, want %q", got, "ad-hoc-token")
+	}
+
+	if _, _, err := client.Authentication.CheckToken(ctx); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Authentication.CheckToken returned error %v, want %v", err, ErrInvalidToken)
+	}
+	if got := client.authentication(); got != "" {
+		t.Errorf("Client kept rejected token %q", got)
+	}
+	if saved, err := store.Load(ctx); err != nil || saved.Token != "stored-token" {
+		t.Errorf("Load returned %+v, %v, want the stored token to be kept", saved, err)
+	}
+}
@@ -0,0 +1,145 @@
+package rememberthemilk
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// TransactionsService handles communication with the transaction related
+// methods of the Remember The Milk API.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/timelines.rtm
+type TransactionsService service
+
+type TransactionUndoOptions struct {
+	Timeline      string `url:"timeline"`
+	TransactionID string `url:"transaction_id"`
+
+	BaseAPIURLOptions
+}
+
+// Undo reverts the effects of an undoable transaction. See Transaction.IsUndoable.
+// If the Client has a TransactionJournal, the transaction is removed from it.
+//
+// Remember The Milk API docs: https://www.rememberthemilk.com/services/api/methods/rtm.transactions.undo.rtm
+func (s *TransactionsService) Undo(ctx context.Context, timeline, transactionID string) (*Response, error) {
+	opts := &TransactionUndoOptions{
+		Timeline:          timeline,
+		TransactionID:     transactionID,
+		BaseAPIURLOptions: s.client.addBaseAPIURLOptions("rtm.transactions.undo"),
+	}
+	u, err := s.client.addOptions("", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	if s.client.Journal != nil {
+		s.client.Journal.remove(timeline, transactionID)
+	}
+	return resp, nil
+}
+
+// Rollback undoes all transactions the Client's TransactionJournal recorded for timeline,
+// in reverse order. It stops at the first failing undo and returns its error;
+// the transactions which have not been undone yet stay in the journal.
+//
+// Rollback is a no-op if the Client has no TransactionJournal.
+func (s *TransactionsService) Rollback(ctx context.Context, timeline string) error {
+	if s.client.Journal == nil {
+		return nil
+	}
+
+	transactions := s.client.Journal.Transactions(timeline)
+	for i := len(transactions) - 1; i >= 0; i-- {
+		if _, err := s.Undo(ctx, timeline, transactions[i].ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TransactionJournal records the undoable transactions of a Client per timeline.
+// Set Client.Journal to enable recording and use TransactionsService.Rollback
+// to undo a whole batch of changes.
+//
+// The zero value is an empty journal ready to use. It is safe for concurrent use.
+type TransactionJournal struct {
+	mu           sync.Mutex
+	transactions map[string][]Transaction
+}
+
+// NewTransactionJournal returns an empty TransactionJournal.
+func NewTransactionJournal() *TransactionJournal {
+	return &TransactionJournal{
+		transactions: make(map[string][]Transaction),
+	}
+}
+
+// Record adds tx to the transactions of timeline.
+// Transactions which are not undoable are ignored.
+func (j *TransactionJournal) Record(timeline string, tx Transaction) {
+	if !tx.IsUndoable() {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.transactions == nil {
+		j.transactions = make(map[string][]Transaction)
+	}
+	j.transactions[timeline] = append(j.transactions[timeline], tx)
+}
+
+// Transactions returns the recorded transactions of timeline in the order they happened.
+func (j *TransactionJournal) Transactions(timeline string) []Transaction {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]Transaction(nil), j.transactions[timeline]...)
+}
+
+// Forget removes all recorded transactions of timeline without undoing them.
+func (j *TransactionJournal) Forget(timeline string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	delete(j.transactions, timeline)
+}
+
+func (j *TransactionJournal) remove(timeline, transactionID string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	transactions := j.transactions[timeline]
+	for i, tx := range transactions {
+		if tx.ID == transactionID {
+			j.transactions[timeline] = append(transactions[:i:i], transactions[i+1:]...)
+			break
+		}
+	}
+	if len(j.transactions[timeline]) == 0 {
+		delete(j.transactions, timeline)
+	}
+}
+
+// recordTransaction records the transaction of a successful response in the Client's
+// TransactionJournal. Only requests which carry a timeline are considered.
+func (c *Client) recordTransaction(req *http.Request, resp *Response) {
+	if c.Journal == nil || resp.Transaction == nil {
+		return
+	}
+	timeline := req.URL.Query().Get("timeline")
+	if timeline == "" {
+		return
+	}
+	c.Journal.Record(timeline, *resp.Transaction)
+}
@@ -0,0 +1,76 @@
+package rememberthemilk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTransactionsService_Rollback(t *testing.T) {
+	client, mux := setup(t)
+	client.Journal = NewTransactionJournal()
+
+	var undone []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("method") {
+		case "rtm.tasks.complete":
+			fmt.Fprintf(w, `{"rsp":{"stat":"ok","transaction":{"id":%q,"undoable":"1"},"list":{"id":"1"}}}`, q.Get("task_id"))
+		case "rtm.tasks.notes.add":
+			fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"note","undoable":"0"},"note":{"id":"1"}}}`)
+		case "rtm.transactions.undo":
+			testParam(t, r, "timeline", "12741021")
+			undone = append(undone, q.Get("transaction_id"))
+			fmt.Fprint(w, `{"rsp":{"stat":"ok"}}`)
+		default:
+			t.Errorf("Unexpected method %q", q.Get("method"))
+		}
+	})
+
+	ctx := context.Background()
+	for _, id := range []string{"1", "2", "3"} {
+		if _, _, err := client.Tasks.Complete(ctx, TaskModifyInput{Timeline: "12741021", TaskID: id}); err != nil {
+			t.Fatalf("Tasks.Complete returned error: %v", err)
+		}
+	}
+	if _, _, err := client.Notes.Add(ctx, NoteAddInput{Timeline: "12741021"}); err != nil {
+		t.Fatalf("Notes.Add returned error: %v", err)
+	}
+	if _, _, err := client.Tasks.Complete(ctx, TaskModifyInput{Timeline: "other", TaskID: "4"}); err != nil {
+		t.Fatalf("Tasks.Complete returned error: %v", err)
+	}
+
+	if err := client.Transactions.Rollback(ctx, "12741021"); err != nil {
+		t.Fatalf("Transactions.Rollback returned error: %v", err)
+	}
+
+	if want := []string{"3", "2", "1"}; !reflect.DeepEqual(undone, want) {
+		t.Errorf("Transactions.Rollback undid %v, want %v", undone, want)
+	}
+	if got := client.Journal.Transactions("12741021"); len(got) != 0 {
+		t.Errorf("Journal still holds %+v after rollback", got)
+	}
+	if got := client.Journal.Transactions("other"); len(got) != 1 {
+		t.Errorf("Journal holds %+v for another timeline, want a single transaction", got)
+	}
+}
+
+func TestTransactionJournal_zeroValue(t *testing.T) {
+	client, mux := setup(t)
+	client.Journal = &TransactionJournal{}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"rsp":{"stat":"ok","transaction":{"id":"4711","undoable":"1"},"list":{"id":"1","name":"Errands"}}}`)
+	})
+
+	if _, _, err := client.Lists.Add(context.Background(), ListAddInput{Timeline: "1", Name: "Errands"}); err != nil {
+		t.Fatalf("Lists.Add returned error: %v", err)
+	}
+
+	want := []Transaction{{ID: "4711", Undoable: "1"}}
+	if got := client.Journal.Transactions("1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Journal.Transactions = %+v, want %+v", got, want)
+	}
+}